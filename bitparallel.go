@@ -0,0 +1,115 @@
+package logo
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// blockPatterns[i] has bit j set iff bit i of j is set, i.e., the words enumerate the
+// values of six variables in 64 assignments.
+var blockPatterns = [6]uint64{
+	0xAAAAAAAAAAAAAAAA,
+	0xCCCCCCCCCCCCCCCC,
+	0xF0F0F0F0F0F0F0F0,
+	0xFF00FF00FF00FF00,
+	0xFFFF0000FFFF0000,
+	0xFFFFFFFF00000000,
+}
+
+// EvalWords evaluates the program for 64 assignments at once: bit j of words[i] is the value
+// of the i-th variable in the j-th assignment, and bit j of the result is the value of the
+// program in the j-th assignment. If there are fewer words than variables, the error wraps
+// ErrUnboundVariable.
+func (p *Program) EvalWords(words []uint64) (uint64, error) {
+	if len(words) < len(p.vars) {
+		return 0, fmt.Errorf("%w: program expects %d words, got %d", ErrUnboundVariable, len(p.vars), len(words))
+	}
+	return p.evalWords(words), nil
+}
+
+// evalWords works like EvalWords for len(words) >= len(p.vars).
+func (p *Program) evalWords(words []uint64) uint64 {
+	return p.run(func(i int) uint64 { return words[i] })
+}
+
+// EvalBlock evaluates the program for the 64 assignments whose codes are block*64, ...,
+// block*64+63, where bit i of a code is the value of the i-th variable (see EvalBits). Bit
+// j of the result is the value of the program for the code block*64+j.
+//
+// A program with n < 6 variables only has 2^n distinct assignments, which repeat in the
+// remaining bits of the result (see BlockMask). Since the block number has 64 bits, the
+// program must not have more than 70 variables; the error wraps ErrTooManyVariables
+// otherwise (see Blocks).
+func (p *Program) EvalBlock(block uint64) (uint64, error) {
+	if len(p.vars) > len(blockPatterns)+64 {
+		return 0, fmt.Errorf("%w to evaluate a block: %d > %d", ErrTooManyVariables, len(p.vars), len(blockPatterns)+64)
+	}
+	return p.run(func(i int) uint64 {
+		if i < len(blockPatterns) {
+			return blockPatterns[i]
+		}
+		return -((block >> (i - len(blockPatterns))) & 1)
+	}), nil
+}
+
+// NumBlocks returns the number of blocks that EvalBlock needs to enumerate all assignments.
+// If the program has more than 69 variables, the number does not fit into an uint64 and the
+// error wraps ErrTooManyVariables.
+func (p *Program) NumBlocks() (uint64, error) {
+	if len(p.vars) > 69 {
+		return 0, fmt.Errorf("%w to enumerate all blocks: %d > 69", ErrTooManyVariables, len(p.vars))
+	}
+	if len(p.vars) <= len(blockPatterns) {
+		return 1, nil
+	}
+	return 1 << (len(p.vars) - len(blockPatterns)), nil
+}
+
+// BlockMask returns the bits of the result of EvalBlock that belong to distinct assignments.
+func (p *Program) BlockMask() uint64 {
+	if len(p.vars) >= len(blockPatterns) {
+		return ^uint64(0)
+	}
+	return 1<<(1<<len(p.vars)) - 1
+}
+
+// Blocks evaluates the program for all assignments, 64 assignments at a time: visit is
+// called with the result of each block in the order of EvalBlock, where the bits that do
+// not belong to distinct assignments are cleared (see BlockMask). The enumeration stops as
+// soon as visit returns false. Unlike EvalBlock, Blocks supports any number of variables,
+// since the blocks are enumerated by an odometer over the variables beyond the sixth.
+func (p *Program) Blocks(visit func(result uint64) bool) {
+	words := make([]uint64, len(p.vars))
+	copy(words, blockPatterns[:])
+	mask := p.BlockMask()
+	for {
+		if !visit(p.evalWords(words) & mask) {
+			return
+		}
+		// advance the odometer
+		i := len(blockPatterns)
+		for ; i < len(words) && words[i] != 0; i++ {
+			words[i] = 0
+		}
+		if i >= len(words) {
+			return
+		}
+		words[i] = ^uint64(0)
+	}
+}
+
+// Signature evaluates the program for 64*rounds random assignments that are drawn from a
+// generator with the given seed. Programs with the same variables that are evaluated with the
+// same seed are not equivalent if their signatures differ.
+func (p *Program) Signature(seed int64, rounds int) []uint64 {
+	rng := rand.New(rand.NewSource(seed))
+	words := make([]uint64, len(p.vars))
+	signature := make([]uint64, rounds)
+	for r := range signature {
+		for i := range words {
+			words[i] = rng.Uint64()
+		}
+		signature[r] = p.evalWords(words)
+	}
+	return signature
+}
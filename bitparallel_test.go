@@ -0,0 +1,139 @@
+package logo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalWords(t *testing.T) {
+	t.Run("evaluates 64 assignments at once", func(t *testing.T) {
+		p := Compile(MustParse("(A -> B) ^ (C !| A)"))
+		words := []uint64{0x0123456789ABCDEF, 0xFEDCBA9876543210, 0x00FF00FF0F0F3333}
+		result, err := p.EvalWords(words)
+		assert.NoError(t, err)
+		for j := 0; j < 64; j++ {
+			bits := uint64(0)
+			for i, word := range words {
+				bits |= ((word >> j) & 1) << i
+			}
+			value, err := p.EvalBits(bits)
+			assert.NoError(t, err)
+			assert.Equal(t, value, (result>>j)&1 == 1)
+		}
+	})
+	t.Run("too few words", func(t *testing.T) {
+		_, err := Compile(MustParse("A & B")).EvalWords([]uint64{1})
+		assert.ErrorIs(t, err, ErrUnboundVariable)
+	})
+}
+
+func TestEvalBlock(t *testing.T) {
+	t.Run("blocks enumerate all assignments", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Var("A"), Nand(Var("B"), Var("C")), Nor(Var("D"), Var("E")), ImpliedBy(Var("F"), Var("G")), Var("H"))
+		p := Compile(f)
+		numBlocks, err := p.NumBlocks()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), numBlocks)
+		assert.Equal(t, ^uint64(0), p.BlockMask())
+		for block := uint64(0); block < numBlocks; block++ {
+			result, err := p.EvalBlock(block)
+			assert.NoError(t, err)
+			for j := uint64(0); j < 64; j++ {
+				value, err := p.EvalBits(block*64 + j)
+				assert.NoError(t, err)
+				assert.Equal(t, value, (result>>j)&1 == 1)
+			}
+		}
+	})
+	t.Run("few variables", func(t *testing.T) {
+		p := Compile(Implies(Var("A"), Var("B")))
+		numBlocks, err := p.NumBlocks()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), numBlocks)
+		assert.Equal(t, uint64(0b1111), p.BlockMask())
+		result, err := p.EvalBlock(0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0b1101), result&p.BlockMask())
+		assert.Equal(t, uint64(1), Compile(Top()).BlockMask())
+	})
+	t.Run("too many variables", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 70; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		p := Compile(NewConjunction(clauses...))
+		_, err := p.NumBlocks()
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+		_, err = p.EvalBits(0)
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+
+		// the block number covers the variables beyond the sixth up to the 70th
+		result, err := p.EvalBlock(^uint64(0))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1)<<63, result)
+		_, err = Compile(NewConjunction(append(clauses, Var("x71"))...)).EvalBlock(0)
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+	})
+}
+
+func TestBlocks(t *testing.T) {
+	t.Run("agrees with EvalBlock", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Var("A"), Nand(Var("B"), Var("C")), Nor(Var("D"), Var("E")), ImpliedBy(Var("F"), Var("G")), Var("H"))
+		p := Compile(f)
+		results := make([]uint64, 0)
+		p.Blocks(func(result uint64) bool {
+			results = append(results, result)
+			return true
+		})
+		numBlocks, err := p.NumBlocks()
+		assert.NoError(t, err)
+		assert.Len(t, results, int(numBlocks))
+		for block, result := range results {
+			expected, err := p.EvalBlock(uint64(block))
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		}
+	})
+	t.Run("few variables are masked", func(t *testing.T) {
+		results := make([]uint64, 0)
+		Compile(Implies(Var("A"), Var("B"))).Blocks(func(result uint64) bool {
+			results = append(results, result)
+			return true
+		})
+		assert.Equal(t, []uint64{0b1101}, results)
+	})
+	t.Run("stops early and supports many variables", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 100; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		visited := 0
+		Compile(NewConjunction(clauses...)).Blocks(func(result uint64) bool {
+			visited++
+			return visited < 3
+		})
+		assert.Equal(t, 3, visited)
+	})
+}
+
+func TestSignature(t *testing.T) {
+	t.Run("equivalent programs have equal signatures", func(t *testing.T) {
+		vars := []string{"A", "B", "C"}
+		p, err := CompileWith(MustParse("!(A & B) | C"), vars)
+		assert.NoError(t, err)
+		q, err := CompileWith(MustParse("A -> (B -> C)"), vars)
+		assert.NoError(t, err)
+		assert.Equal(t, p.Signature(42, 4), q.Signature(42, 4))
+		assert.Len(t, p.Signature(42, 4), 4)
+	})
+	t.Run("non-equivalent programs have different signatures", func(t *testing.T) {
+		vars := []string{"A", "B", "C"}
+		p, err := CompileWith(MustParse("A & B & C"), vars)
+		assert.NoError(t, err)
+		q, err := CompileWith(MustParse("A & B & !C"), vars)
+		assert.NoError(t, err)
+		assert.NotEqual(t, p.Signature(42, 1), q.Signature(42, 1))
+	})
+}
@@ -0,0 +1,217 @@
+package bruteforce
+
+import (
+	"math/big"
+	"math/bits"
+
+	. "github.com/dmholtz/logo"
+)
+
+// Counter counts the models of a formula, i.e., the number of assignments of the variables
+// of the formula (see Vars) that satisfy it.
+type Counter interface {
+	Count(f LogicNode) *big.Int
+}
+
+// EnumerationCounter counts models by evaluating the formula for all possible assignments.
+// There is no limit on the number of variables.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+type EnumerationCounter struct{}
+
+// ComponentCounter counts models by splitting the formula into independent components,
+// i.e., conjuncts or disjuncts without common variables, which are counted separately,
+// and by case distinction on the most frequent variable otherwise. The counts of
+// subformulas are cached up to renaming of variables (see Normalize).
+//
+// The runtime is exponential in the worst case, but formulas with many variables can be
+// counted as long as they decompose well.
+type ComponentCounter struct{}
+
+// Count returns the number of models of the formula f using an EnumerationCounter.
+func Count(f LogicNode) *big.Int {
+	return EnumerationCounter{}.Count(f)
+}
+
+func (EnumerationCounter) Count(f LogicNode) *big.Int {
+	count := new(big.Int)
+	// the partial count is flushed before it can overflow
+	var partial uint64
+	Compile(f).Blocks(func(result uint64) bool {
+		partial += uint64(bits.OnesCount64(result))
+		if partial >= 1<<62 {
+			count.Add(count, new(big.Int).SetUint64(partial))
+			partial = 0
+		}
+		return true
+	})
+	return count.Add(count, new(big.Int).SetUint64(partial))
+}
+
+func (ComponentCounter) Count(f LogicNode) *big.Int {
+	c := &componentCounter{cache: make(map[uint64][]cachedCount)}
+	restricted := Restrict(f, Assignment{})
+	// variables that vanish by constant folding are free
+	free := len(Vars(f)) - len(Vars(restricted))
+	return new(big.Int).Lsh(c.count(restricted), uint(free))
+}
+
+type cachedCount struct {
+	f     LogicNode // normalized formula
+	count *big.Int
+}
+
+type componentCounter struct {
+	cache map[uint64][]cachedCount
+}
+
+// count returns the number of models of f, which must not contain constants except for
+// f being a Leaf itself (see Restrict). The result must not be modified.
+func (c *componentCounter) count(f LogicNode) *big.Int {
+	if l, ok := f.(Leaf); ok {
+		if l {
+			return big.NewInt(1)
+		}
+		return big.NewInt(0)
+	}
+
+	normalized := Normalize(f)
+	key := Hash(normalized)
+	for _, entry := range c.cache[key] {
+		if Equal(normalized, entry.f) {
+			return entry.count
+		}
+	}
+
+	count := c.decompose(f)
+	c.cache[key] = append(c.cache[key], cachedCount{f: normalized, count: count})
+	return count
+}
+
+func (c *componentCounter) decompose(f LogicNode) *big.Int {
+	numVars := len(f.Scope())
+	switch f1 := f.(type) {
+	case *NotOp:
+		return new(big.Int).Sub(pow2(numVars), c.count(f1.X))
+	case *BinaryOp, *NaryOp:
+		if op, ok := junction(f1); ok {
+			components := splitComponents(flatten(f1, op))
+			if len(components) > 1 {
+				return c.countComponents(op, components, numVars)
+			}
+		}
+	}
+	return c.branch(f, numVars)
+}
+
+// countComponents counts a conjunction or disjunction of components without common variables.
+func (c *componentCounter) countComponents(op OpType, components [][]LogicNode, numVars int) *big.Int {
+	product := big.NewInt(1)
+	for _, component := range components {
+		g := LogicNode(&NaryOp{Clauses: component, Op: op})
+		if len(component) == 1 {
+			g = component[0]
+		}
+		count := c.count(g)
+		if op == OrOp {
+			// count the non-models of the disjunct
+			count = new(big.Int).Sub(pow2(len(g.Scope())), count)
+		}
+		product.Mul(product, count)
+	}
+	if op == OrOp {
+		return product.Sub(pow2(numVars), product)
+	}
+	return product
+}
+
+// branch counts the models of f by case distinction on its most frequent variable.
+func (c *componentCounter) branch(f LogicNode, numVars int) *big.Int {
+	occurrences := Stats(f).Occurrences
+	pivot := ""
+	for _, name := range Vars(f) {
+		if pivot == "" || occurrences[name] > occurrences[pivot] {
+			pivot = name
+		}
+	}
+
+	count := new(big.Int)
+	for _, value := range []bool{false, true} {
+		g := Restrict(f, Assignment{pivot: value})
+		// variables that vanish from g are free
+		free := numVars - 1 - len(g.Scope())
+		count.Add(count, new(big.Int).Lsh(c.count(g), uint(free)))
+	}
+	return count
+}
+
+// junction returns the operator of a conjunction or disjunction.
+func junction(f LogicNode) (OpType, bool) {
+	switch f1 := f.(type) {
+	case *BinaryOp:
+		return f1.Op, f1.Op == AndOp || f1.Op == OrOp
+	case *NaryOp:
+		return f1.Op, f1.Op == AndOp || f1.Op == OrOp
+	}
+	return 0, false
+}
+
+// flatten returns the operands of nested conjunctions or disjunctions with operator op.
+func flatten(f LogicNode, op OpType) []LogicNode {
+	if fOp, ok := junction(f); !ok || fOp != op {
+		return []LogicNode{f}
+	}
+	operands := make([]LogicNode, 0)
+	for _, child := range Children(f) {
+		operands = append(operands, flatten(child, op)...)
+	}
+	return operands
+}
+
+// splitComponents partitions the operands into groups such that operands of different
+// groups have no common variables.
+func splitComponents(operands []LogicNode) [][]LogicNode {
+	// union-find over the operand indices
+	parent := make([]int, len(operands))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owner := make(map[string]int)
+	for i, operand := range operands {
+		for name := range operand.Scope() {
+			if j, ok := owner[name]; ok {
+				parent[find(i)] = find(j)
+			} else {
+				owner[name] = i
+			}
+		}
+	}
+
+	groups := make(map[int]int)
+	components := make([][]LogicNode, 0)
+	for i, operand := range operands {
+		root := find(i)
+		g, ok := groups[root]
+		if !ok {
+			g = len(components)
+			groups[root] = g
+			components = append(components, nil)
+		}
+		components[g] = append(components[g], operand)
+	}
+	return components
+}
+
+// pow2 returns 2^n.
+func pow2(n int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(n))
+}
@@ -0,0 +1,94 @@
+package bruteforce
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+func TestCount(t *testing.T) {
+	t.Run("small formulas", func(t *testing.T) {
+		tests := map[string]int64{
+			"A":                      1,
+			"A | B":                  3,
+			"A ^ B ^ C":              4,
+			"A & !A":                 0,
+			"(A -> B) & (B -> C)":    4,
+			"(A <-> B) | (C !& D)":   14,
+			"true":                   1,
+			"false":                  0,
+			"(A & B) | (!A & C) | D": 12,
+		}
+		for s, expected := range tests {
+			assert.Equal(t, big.NewInt(expected), Count(MustParse(s)), s)
+		}
+	})
+	t.Run("formulas with many variables", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 12; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		assert.Equal(t, big.NewInt(1<<11), Count(NewExclusiveDisjunction(clauses...)))
+		assert.Equal(t, big.NewInt(1<<12-1), Count(NewDisjunction(clauses...)))
+	})
+}
+
+func TestComponentCounter(t *testing.T) {
+	counters := []Counter{EnumerationCounter{}, ComponentCounter{}}
+
+	t.Run("agrees with enumeration", func(t *testing.T) {
+		formulas := []string{
+			"A",
+			"!A",
+			"A & !A",
+			"true",
+			"(A & B) | (!A & C) | D",
+			"((A ^ B) !& (C | !A)) <- (A !| (B -> C)) <-> (A <-> C)",
+			"(A | B) & (C | D) & (E | !F) & (A -> E)",
+			"!((A & B) | (C & D)) | (E <-> F)",
+			"(A -> B) & (B -> C) & (C -> D) & (D -> A)",
+			"(A & false) | B",
+			"!(A & false) | B",
+			"(A -> true) & B",
+			"false -> C",
+			"(A ^ true) & (B | (C & false))",
+		}
+		for _, s := range formulas {
+			f := MustParse(s)
+			for _, counter := range counters {
+				assert.Equal(t, Count(f), counter.Count(f), "%T: %s", counter, s)
+			}
+		}
+	})
+	t.Run("scales past the enumeration limit", func(t *testing.T) {
+		// (x1 | y1) & ... & (x40 | y40) has 3^40 models over 80 variables
+		clauses := []LogicNode{}
+		for i := 0; i < 40; i++ {
+			clauses = append(clauses, Or(Var(fmt.Sprintf("x%d", i)), Var(fmt.Sprintf("y%d", i))))
+		}
+		expected := new(big.Int).Exp(big.NewInt(3), big.NewInt(40), nil)
+		assert.Equal(t, expected, ComponentCounter{}.Count(NewConjunction(clauses...)))
+
+		// the negation has 4^40 - 3^40 models
+		negated := new(big.Int).Sub(new(big.Int).Exp(big.NewInt(4), big.NewInt(40), nil), expected)
+		assert.Equal(t, negated, ComponentCounter{}.Count(Not(NewConjunction(clauses...))))
+	})
+	t.Run("chain of implications", func(t *testing.T) {
+		// x0 -> x1 -> ... -> x49 (as conjunction of implications) has 51 models
+		clauses := []LogicNode{}
+		for i := 0; i < 49; i++ {
+			clauses = append(clauses, Implies(Var(fmt.Sprintf("x%d", i)), Var(fmt.Sprintf("x%d", i+1))))
+		}
+		assert.Equal(t, big.NewInt(51), ComponentCounter{}.Count(NewConjunction(clauses...)))
+	})
+	t.Run("result may be modified", func(t *testing.T) {
+		f := MustParse("A | B")
+		count := ComponentCounter{}.Count(f)
+		count.SetInt64(0)
+		assert.Equal(t, big.NewInt(3), ComponentCounter{}.Count(f))
+	})
+}
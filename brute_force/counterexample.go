@@ -0,0 +1,35 @@
+package bruteforce
+
+import . "github.com/dmholtz/logo"
+
+// FindCounterexample returns an assignment of the variables of f and g for which f and g
+// have different truth values, if f and g are not equivalent. The counterexample is the
+// first one in the order of the rows of a truth table (see Models). If f and g have more
+// than 63 variables together, the error wraps ErrTooManyVariables.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+func FindCounterexample(f, g LogicNode) (Assignment, bool, error) {
+	it := Models(Xor(f, g), Limit(1))
+	if it.Next() {
+		return it.Model(), true, nil
+	}
+	return nil, false, it.Err()
+}
+
+// IsTautWitness returns true iff the given formula f is a tautology. Otherwise, it also
+// returns an assignment for which f is false. If f has more than 63 variables, the error
+// wraps ErrTooManyVariables.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+func IsTautWitness(f LogicNode) (Assignment, bool, error) {
+	it := Models(Not(f), Limit(1))
+	if it.Next() {
+		return it.Model(), false, nil
+	}
+	if err := it.Err(); err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
@@ -0,0 +1,74 @@
+package bruteforce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+func TestFindCounterexample(t *testing.T) {
+	t.Run("equivalent formulas have no counterexample", func(t *testing.T) {
+		_, found, err := FindCounterexample(MustParse("!(A & B)"), MustParse("!A | !B"))
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+	t.Run("counterexample of a wrong rewrite", func(t *testing.T) {
+		original := MustParse("!(A & B)")
+		answer := MustParse("!A & !B")
+		a, found, err := FindCounterexample(answer, original)
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, Assignment{"A": false, "B": true}, a)
+		assert.NotEqual(t, answer.Eval(a), original.Eval(a))
+
+		message := fmt.Sprintf("for %s your formula is %t but the original is %t", a, answer.Eval(a), original.Eval(a))
+		assert.Equal(t, "for A=false, B=true your formula is false but the original is true", message)
+	})
+	t.Run("formulas with different variables", func(t *testing.T) {
+		a, found, err := FindCounterexample(Var("A"), And(Var("A"), Var("B")))
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, Assignment{"A": true, "B": false}, a)
+	})
+	t.Run("too many variables", func(t *testing.T) {
+		a, found, err := FindCounterexample(disjunction(64), Top())
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+		assert.False(t, found)
+		assert.Nil(t, a)
+	})
+}
+
+func TestIsTautWitness(t *testing.T) {
+	t.Run("tautology", func(t *testing.T) {
+		a, taut, err := IsTautWitness(MustParse("(A -> B) | A"))
+		assert.NoError(t, err)
+		assert.True(t, taut)
+		assert.Nil(t, a)
+	})
+	t.Run("falsifying assignment", func(t *testing.T) {
+		f := MustParse("(A -> B) -> (B -> A)")
+		a, taut, err := IsTautWitness(f)
+		assert.NoError(t, err)
+		assert.False(t, taut)
+		assert.Equal(t, Assignment{"A": false, "B": true}, a)
+		assert.False(t, f.Eval(a))
+	})
+	t.Run("too many variables", func(t *testing.T) {
+		a, taut, err := IsTautWitness(disjunction(64))
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+		assert.False(t, taut)
+		assert.Nil(t, a)
+	})
+}
+
+// disjunction returns x0 | ... | x(n-1).
+func disjunction(n int) LogicNode {
+	clauses := make([]LogicNode, n)
+	for i := range clauses {
+		clauses[i] = Var(fmt.Sprintf("x%d", i))
+	}
+	return NewDisjunction(clauses...)
+}
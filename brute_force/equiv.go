@@ -3,10 +3,14 @@ package bruteforce
 import . "github.com/dmholtz/logo"
 
 // IsEquiv returns true iff the given formulas f and g are equivalent.
-// It does so by checking whether the formula (f <-> g) is a tautology.
+// It does so by checking whether the formula (f <-> g) is a tautology, unless
+// their signatures already show that f and g differ (see SignaturesDiffer).
 //
 // The runtime of this approach is exponential and thus only feasible
 // for small formulas.
 func IsEquiv(f, g LogicNode) bool {
+	if SignaturesDiffer(f, g, 1) {
+		return false
+	}
 	return IsTaut(Iff(f, g))
 }
@@ -0,0 +1,19 @@
+package bruteforce
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/dmholtz/logo"
+)
+
+// ErrInvalidOption is returned if an option has an invalid value, e.g., Limit(0).
+var ErrInvalidOption = errors.New("invalid option")
+
+// checkVars returns an error that wraps ErrTooManyVariables if vars has more than max variables.
+func checkVars(f LogicNode, vars []string, max int) error {
+	if len(vars) > max {
+		return fmt.Errorf("%w in formula f=%s: %d > %d", ErrTooManyVariables, f, len(vars), max)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package bruteforce
+
+import (
+	"fmt"
+	"math/bits"
+
+	. "github.com/dmholtz/logo"
+)
+
+// ModelOption configures the enumeration of Models.
+type ModelOption func(*ModelIterator)
+
+// Project projects the models onto the given variables: each model only assigns these
+// variables, and every projected model is reported once. The variables may include
+// variables that do not occur in the formula.
+func Project(vars ...string) ModelOption {
+	return func(it *ModelIterator) {
+		it.project = NewVarIndex(vars...).Names()
+	}
+}
+
+// Limit stops the enumeration after n models. If n is not positive, the enumeration stops
+// immediately with an error that wraps ErrInvalidOption.
+func Limit(n int) ModelOption {
+	return func(it *ModelIterator) {
+		if n < 1 {
+			it.err = fmt.Errorf("%w: limit=%d must be positive", ErrInvalidOption, n)
+			return
+		}
+		it.limit = n
+	}
+}
+
+// ModelIterator enumerates the satisfying assignments (models) of a formula. Like a
+// bufio.Scanner, it is used as follows:
+//
+//	it := Models(f)
+//	for it.Next() {
+//		model := it.Model()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ModelIterator struct {
+	project []string
+	limit   int
+
+	vars     []string // the projected variables come first
+	program  *Program
+	mask     uint64
+	numFree  int    // number of variables that are not projected
+	code     uint64 // code of the next assignment to check (see compileRowMajor)
+	end      uint64
+	block    uint64
+	word     uint64 // result of the block, if the block is evaluated
+	hasWord  bool
+	count    int
+	current  Assignment
+	finished bool
+	err      error
+}
+
+// Models returns an iterator over all models of the formula f. The models are enumerated in
+// the order of the rows of the truth table of f (see TruthTable), i.e., with the variables in
+// natural sort order and false before true. Projected models are enumerated in the order of
+// the projected variables.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+func Models(f LogicNode, options ...ModelOption) *ModelIterator {
+	it := &ModelIterator{}
+	for _, option := range options {
+		option(it)
+	}
+	if it.err != nil {
+		it.finished = true
+		return it
+	}
+
+	if it.project == nil {
+		it.vars = Vars(f)
+	} else {
+		vars := NewVarIndex(it.project...)
+		for _, name := range Vars(f) {
+			vars.Intern(name)
+		}
+		it.vars = vars.Names()
+		it.numFree = len(it.vars) - len(it.project)
+	}
+	// the codes of the assignments must fit into an uint64
+	if it.err = checkVars(f, it.vars, 63); it.err != nil {
+		it.finished = true
+		return it
+	}
+
+	// it.vars contains all variables of f, so the compilation cannot fail
+	it.program, _ = compileRowMajor(f, it.vars)
+	it.mask = it.program.BlockMask()
+	it.end = 1 << len(it.vars)
+	return it
+}
+
+// Next advances the iterator to the next model, which is then available through Model.
+// It returns false when there are no more models or the limit is reached.
+func (it *ModelIterator) Next() bool {
+	it.current = nil
+	if it.finished || (it.limit > 0 && it.count >= it.limit) {
+		it.finished = true
+		return false
+	}
+
+	for it.code < it.end {
+		block := it.code / 64
+		if !it.hasWord || it.block != block {
+			// the number of variables has been checked, so the block can be evaluated
+			word, _ := it.program.EvalBlock(block)
+			it.block, it.word, it.hasWord = block, word&it.mask, true
+		}
+		remaining := it.word >> (it.code % 64)
+		if remaining == 0 {
+			it.code = (block + 1) * 64
+			continue
+		}
+		it.code += uint64(bits.TrailingZeros64(remaining))
+		if it.code >= it.end {
+			break
+		}
+
+		it.current = decode(it.code, it.vars, len(it.vars)-it.numFree)
+		// skip the other models with the same projection
+		it.code = (it.code>>it.numFree + 1) << it.numFree
+		it.count++
+		return true
+	}
+	it.finished = true
+	return false
+}
+
+// Err returns the error that stopped the enumeration, if any. If the formula has more than
+// 63 variables, including the projected variables, the error wraps ErrTooManyVariables. If
+// an option is invalid, the error wraps ErrInvalidOption.
+func (it *ModelIterator) Err() error {
+	return it.err
+}
+
+// Model returns the model found by the last call of Next.
+func (it *ModelIterator) Model() Assignment {
+	return it.current
+}
+
+// All returns all remaining models.
+func (it *ModelIterator) All() []Assignment {
+	models := make([]Assignment, 0)
+	for it.Next() {
+		models = append(models, it.Model())
+	}
+	return models
+}
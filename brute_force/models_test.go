@@ -0,0 +1,88 @@
+package bruteforce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+func TestModels(t *testing.T) {
+	t.Run("all models in truth table order", func(t *testing.T) {
+		models := Models(MustParse("A ^ B")).All()
+		assert.Equal(t, []Assignment{{"A": false, "B": true}, {"A": true, "B": false}}, models)
+	})
+	t.Run("unsatisfiable formula has no models", func(t *testing.T) {
+		assert.Empty(t, Models(MustParse("A & !A")).All())
+		assert.Empty(t, Models(Bottom()).All())
+	})
+	t.Run("constant formula has the empty model", func(t *testing.T) {
+		assert.Equal(t, []Assignment{{}}, Models(Top()).All())
+	})
+	t.Run("models agree with the truth table", func(t *testing.T) {
+		f := MustParse("(A ^ B ^ C) -> ((D | E) & (F <-> G))")
+		expected := make([]Assignment, 0)
+		table, err := NewTruthTable(f)
+		assert.NoError(t, err)
+		for _, row := range table.Rows {
+			if row.Result {
+				expected = append(expected, row.Assignment)
+			}
+		}
+		assert.Equal(t, expected, Models(f).All())
+	})
+	t.Run("scanner-style iteration", func(t *testing.T) {
+		it := Models(MustParse("A | B"))
+		count := 0
+		for it.Next() {
+			assert.True(t, it.Model()["A"] || it.Model()["B"])
+			count++
+		}
+		assert.Equal(t, 3, count)
+		assert.False(t, it.Next())
+		assert.Nil(t, it.Model())
+	})
+	t.Run("limit", func(t *testing.T) {
+		models := Models(MustParse("A | B | C"), Limit(2)).All()
+		assert.Equal(t, []Assignment{{"A": false, "B": false, "C": true}, {"A": false, "B": true, "C": false}}, models)
+
+		it := Models(MustParse("A | B | C"), Limit(0))
+		assert.False(t, it.Next())
+		assert.ErrorIs(t, it.Err(), ErrInvalidOption)
+	})
+	t.Run("projection", func(t *testing.T) {
+		f := MustParse("(A | B) & (C -> A)")
+		assert.Equal(t, []Assignment{{"A": false}, {"A": true}}, Models(f, Project("A")).All())
+		assert.Equal(t, []Assignment{{"C": false, "B": false}, {"C": false, "B": true}, {"C": true, "B": false}, {"C": true, "B": true}},
+			Models(f, Project("C", "B")).All())
+		assert.Equal(t, []Assignment{{"C": false}}, Models(MustParse("!C & A & B"), Project("C")).All())
+		assert.Equal(t, []Assignment{{}}, Models(f, Project()).All())
+	})
+	t.Run("projection onto variables outside the formula", func(t *testing.T) {
+		models := Models(Var("A"), Project("A", "Z"), Limit(5)).All()
+		assert.Equal(t, []Assignment{{"A": true, "Z": false}, {"A": true, "Z": true}}, models)
+	})
+	t.Run("many models across blocks", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 10; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		assert.Len(t, Models(NewExclusiveDisjunction(clauses...)).All(), 512)
+		assert.Len(t, Models(NewExclusiveDisjunction(clauses...), Project("x1", "x2", "x3")).All(), 8)
+	})
+	t.Run("too many variables", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 64; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		it := Models(NewDisjunction(clauses...))
+		assert.False(t, it.Next())
+		assert.ErrorIs(t, it.Err(), ErrTooManyVariables)
+
+		it = Models(Var("x1"), Project("x1", "x2"))
+		assert.Len(t, it.All(), 2)
+		assert.NoError(t, it.Err())
+	})
+}
@@ -0,0 +1,154 @@
+package bruteforce
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/dmholtz/logo"
+)
+
+// chunkBlocks is the number of blocks (see EvalBlock) that a worker checks before it
+// looks for cancellation and reports progress.
+const chunkBlocks = 1024
+
+// SearchOption configures the context-aware searches IsSatCtx, IsTautCtx and IsEquivCtx.
+type SearchOption func(*search)
+
+// Workers sets the number of goroutines that search in parallel. By default, it is
+// runtime.GOMAXPROCS(0). If n is not positive, the search fails with an error that wraps
+// ErrInvalidOption.
+func Workers(n int) SearchOption {
+	return func(s *search) {
+		if n < 1 {
+			s.err = fmt.Errorf("%w: number of workers=%d must be positive", ErrInvalidOption, n)
+			return
+		}
+		s.workers = n
+	}
+}
+
+// Progress reports the number of checked assignments out of the total number of
+// assignments. The calls of fn are serialized, and done increases with every call.
+func Progress(fn func(done, total uint64)) SearchOption {
+	return func(s *search) {
+		s.progress = fn
+	}
+}
+
+type search struct {
+	workers  int
+	progress func(done, total uint64)
+	err      error // invalid option
+
+	program *Program
+	mask    uint64
+	total   uint64 // number of assignments
+	next    uint64 // next chunk to check, accessed atomically
+	found   atomic.Bool
+
+	mu   sync.Mutex // serializes the progress reports
+	done uint64
+}
+
+// IsSatCtx returns true iff the given formula f is satisfiable. Unlike IsSat, it splits
+// the assignments among parallel workers, which stop as soon as one of them finds a model.
+// If ctx is done before the search is complete, IsSatCtx returns ctx.Err(). If f has more
+// than 63 variables, the error wraps ErrTooManyVariables. If an option is invalid, the
+// error wraps ErrInvalidOption.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+func IsSatCtx(ctx context.Context, f LogicNode, options ...SearchOption) (bool, error) {
+	s := &search{workers: runtime.GOMAXPROCS(0)}
+	for _, option := range options {
+		option(s)
+	}
+	if s.err != nil {
+		return false, s.err
+	}
+
+	s.program = Compile(f)
+	// the number of assignments must fit into an uint64
+	if err := checkVars(f, s.program.Vars(), 63); err != nil {
+		return false, err
+	}
+	s.mask = s.program.BlockMask()
+	s.total = 1 << len(s.program.Vars())
+
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	if s.found.Load() {
+		return true, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// IsTautCtx returns true iff the given formula f is a tautology (see IsSatCtx).
+func IsTautCtx(ctx context.Context, f LogicNode, options ...SearchOption) (bool, error) {
+	sat, err := IsSatCtx(ctx, Not(f), options...)
+	if err != nil {
+		return false, err
+	}
+	return !sat, nil
+}
+
+// IsEquivCtx returns true iff the given formulas f and g are equivalent (see IsSatCtx).
+func IsEquivCtx(ctx context.Context, f, g LogicNode, options ...SearchOption) (bool, error) {
+	if SignaturesDiffer(f, g, 1) {
+		return false, nil
+	}
+	return IsTautCtx(ctx, Iff(f, g), options...)
+}
+
+// work checks chunks of blocks until a model is found, ctx is done or all chunks are checked.
+func (s *search) work(ctx context.Context) {
+	// the number of variables has been checked, so the blocks can be counted
+	numBlocks, _ := s.program.NumBlocks()
+	for !s.found.Load() && ctx.Err() == nil {
+		chunk := atomic.AddUint64(&s.next, 1) - 1
+		start := chunk * chunkBlocks
+		if start >= numBlocks {
+			return
+		}
+		end := start + chunkBlocks
+		if end > numBlocks {
+			end = numBlocks
+		}
+
+		for block := start; block < end; block++ {
+			if result, _ := s.program.EvalBlock(block); result&s.mask != 0 {
+				s.found.Store(true)
+				return
+			}
+		}
+		s.report((end - start) * 64)
+	}
+}
+
+func (s *search) report(checked uint64) {
+	if s.progress == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.done += checked
+	if s.done > s.total {
+		// blocks of formulas with less than 6 variables repeat the assignments
+		s.done = s.total
+	}
+	s.progress(s.done, s.total)
+}
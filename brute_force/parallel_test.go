@@ -0,0 +1,103 @@
+package bruteforce
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+// parity returns the exclusive disjunction of n variables.
+func parity(n int) LogicNode {
+	clauses := []LogicNode{}
+	for i := 0; i < n; i++ {
+		clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+	}
+	return NewExclusiveDisjunction(clauses...)
+}
+
+func TestIsSatCtx(t *testing.T) {
+	ctx := context.Background()
+	t.Run("agrees with IsSat", func(t *testing.T) {
+		formulas := []LogicNode{
+			Top(),
+			Bottom(),
+			MustParse("A & !A"),
+			MustParse("(A <-> B) & B"),
+			And(parity(20), Not(parity(20))),
+			And(parity(20), NewConjunction(Var("x1"), Var("x2"), Var("x3"))),
+		}
+		for _, f := range formulas {
+			for _, workers := range []int{1, 4} {
+				sat, err := IsSatCtx(ctx, f, Workers(workers))
+				assert.NoError(t, err)
+				assert.Equal(t, IsSat(f), sat, f.String())
+			}
+		}
+	})
+	t.Run("cancellation", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := IsSatCtx(cancelled, And(parity(20), Not(parity(20))))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := IsSatCtx(timeout, And(parity(31), Not(parity(31))), Workers(2))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+	t.Run("progress", func(t *testing.T) {
+		var reports []uint64
+		sat, err := IsSatCtx(ctx, And(parity(18), Not(parity(18))), Workers(3), Progress(func(done, total uint64) {
+			assert.Equal(t, uint64(1<<18), total)
+			reports = append(reports, done)
+		}))
+		assert.NoError(t, err)
+		assert.False(t, sat)
+		assert.IsIncreasing(t, reports)
+		assert.Equal(t, uint64(1<<18), reports[len(reports)-1])
+	})
+	t.Run("too many variables", func(t *testing.T) {
+		_, err := IsSatCtx(ctx, parity(64))
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+	})
+	t.Run("invalid number of workers", func(t *testing.T) {
+		_, err := IsSatCtx(ctx, MustParse("A | B"), Workers(0))
+		assert.ErrorIs(t, err, ErrInvalidOption)
+	})
+	t.Run("progress of small formulas", func(t *testing.T) {
+		var last uint64
+		_, err := IsSatCtx(ctx, MustParse("A & !A"), Progress(func(done, total uint64) { last = done }))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), last)
+	})
+}
+
+func TestIsTautCtxAndIsEquivCtx(t *testing.T) {
+	ctx := context.Background()
+	t.Run("tautology", func(t *testing.T) {
+		taut, err := IsTautCtx(ctx, MustParse("A | !A"))
+		assert.NoError(t, err)
+		assert.True(t, taut)
+
+		taut, err = IsTautCtx(ctx, MustParse("A | B"))
+		assert.NoError(t, err)
+		assert.False(t, taut)
+	})
+	t.Run("equivalence", func(t *testing.T) {
+		equiv, err := IsEquivCtx(ctx, MustParse("!(A & B)"), MustParse("!A | !B"))
+		assert.NoError(t, err)
+		assert.True(t, equiv)
+
+		equiv, err = IsEquivCtx(ctx, MustParse("!(A & B)"), MustParse("!A & !B"))
+		assert.NoError(t, err)
+		assert.False(t, equiv)
+	})
+}
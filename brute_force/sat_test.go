@@ -28,13 +28,15 @@ func TestIsSat(t *testing.T) {
 	t.Run("(A <-> B) & A & !B is not satisfiable", func(t *testing.T) {
 		assert.False(t, IsSat(And(Iff(Var("A"), Var("B")), And(Var("A"), Not(Var("B"))))))
 	})
-	t.Run("large formula is rejected", func(t *testing.T) {
-		clauses := []LogicNode{}
-		for i := 0; i < 32; i++ {
-			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+	t.Run("formulas with more than 31 variables are supported", func(t *testing.T) {
+		for _, n := range []int{32, 64, 100} {
+			clauses := []LogicNode{}
+			for i := 0; i < n; i++ {
+				clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+			}
+			assert.True(t, IsSat(NewDisjunction(clauses...)))
+			// the only model sets all variables to false
+			assert.True(t, IsSat(Not(NewDisjunction(clauses...))))
 		}
-		conjunction := NewConjunction(clauses...)
-		assert.Panics(t, func() { IsSat(conjunction) })
 	})
-
 }
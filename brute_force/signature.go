@@ -0,0 +1,30 @@
+package bruteforce
+
+import . "github.com/dmholtz/logo"
+
+// signatureSeed is the seed of the random assignments of signatures.
+const signatureSeed = 1
+
+// SignaturesDiffer evaluates the formulas f and g for 64*rounds random assignments (see
+// Program.Signature). It returns true only if f and g are not equivalent; if it returns
+// false, f and g agree on all evaluated assignments and may or may not be equivalent.
+//
+// Unlike IsEquiv, the runtime is linear in the size of the formulas and in rounds.
+func SignaturesDiffer(f, g LogicNode, rounds int) bool {
+	vars := NewVarIndexOf(f)
+	for _, name := range Vars(g) {
+		vars.Intern(name)
+	}
+
+	// vars contains all variables of f and g, so the compilation cannot fail
+	fProgram, _ := CompileWith(f, vars.Names())
+	gProgram, _ := CompileWith(g, vars.Names())
+	fSignature := fProgram.Signature(signatureSeed, rounds)
+	gSignature := gProgram.Signature(signatureSeed, rounds)
+	for r := range fSignature {
+		if fSignature[r] != gSignature[r] {
+			return true
+		}
+	}
+	return false
+}
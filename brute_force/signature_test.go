@@ -0,0 +1,26 @@
+package bruteforce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+func TestSignaturesDiffer(t *testing.T) {
+	t.Run("equivalent formulas", func(t *testing.T) {
+		f := MustParse("!(A | B) & C")
+		g := MustParse("!A & !B & C")
+		assert.False(t, SignaturesDiffer(f, g, 4))
+	})
+	t.Run("formulas with different variables", func(t *testing.T) {
+		assert.True(t, SignaturesDiffer(Var("A"), Var("B"), 1))
+		assert.False(t, SignaturesDiffer(Var("A"), And(Var("A"), Or(Var("B"), Not(Var("B")))), 1))
+	})
+	t.Run("formulas differing in few assignments", func(t *testing.T) {
+		f := MustParse("A & B & C & D")
+		g := MustParse("A & B & C & D & E")
+		assert.True(t, SignaturesDiffer(f, g, 4))
+	})
+}
@@ -0,0 +1,247 @@
+package bruteforce
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+
+	. "github.com/dmholtz/logo"
+)
+
+// maxTableVars is the maximum number of variables of a formula whose truth table is built.
+const maxTableVars = 20
+
+// TruthTable is the truth table of a formula.
+type TruthTable struct {
+	Formula LogicNode
+	// Vars are the variable names of the formula in natural sort order (see logo.Vars).
+	Vars []string
+	// Columns are the distinct compound subformulas of the formula in the order in which
+	// they are evaluated, i.e., each subformula comes after its own subformulas. The
+	// formula itself is not included.
+	Columns []LogicNode
+	// Rows contain one row per assignment, starting with all variables false and
+	// counting in binary with the first variable as the most significant bit.
+	Rows []Row
+}
+
+// Row is a row of a truth table.
+type Row struct {
+	Assignment Assignment
+	Values     []bool // the values of the Columns
+	Result     bool   // the value of the formula
+}
+
+// NewTruthTable builds the truth table of the formula f without subformula columns.
+// If f has more than 20 variables, the error wraps ErrTooManyVariables.
+//
+// The runtime of this approach is exponential and thus only feasible
+// for small formulas.
+func NewTruthTable(f LogicNode) (*TruthTable, error) {
+	return newTruthTable(f, nil)
+}
+
+// NewTruthTableWithSubformulas builds the truth table of the formula f with a column for
+// every compound subformula of f.
+func NewTruthTableWithSubformulas(f LogicNode) (*TruthTable, error) {
+	columns := make([]LogicNode, 0)
+	seen := make(map[uint64][]LogicNode)
+	var collect func(node LogicNode)
+	collect = func(node LogicNode) {
+		children := Children(node)
+		for _, child := range children {
+			collect(child)
+		}
+		if len(children) == 0 || node == f {
+			return
+		}
+		key := Hash(node)
+		for _, other := range seen[key] {
+			if Equal(node, other) {
+				return
+			}
+		}
+		seen[key] = append(seen[key], node)
+		columns = append(columns, node)
+	}
+	collect(f)
+	return newTruthTable(f, columns)
+}
+
+func newTruthTable(f LogicNode, columns []LogicNode) (*TruthTable, error) {
+	vars := Vars(f)
+	if err := checkVars(f, vars, maxTableVars); err != nil {
+		return nil, err
+	}
+
+	// the columns are subformulas of f, so the compilation cannot fail
+	program, _ := compileRowMajor(f, vars)
+	programs := make([]*Program, len(columns))
+	for i, column := range columns {
+		programs[i], _ = compileRowMajor(column, vars)
+	}
+
+	t := &TruthTable{Formula: f, Vars: vars, Columns: columns, Rows: make([]Row, 0, 1<<len(vars))}
+	results := make([]uint64, len(columns))
+	var result uint64
+	for coded := 0; coded < (1 << len(vars)); coded++ {
+		bit := coded % 64
+		if bit == 0 {
+			// the number of variables has been checked, so the blocks can be evaluated
+			block := uint64(coded / 64)
+			for i, p := range programs {
+				results[i], _ = p.EvalBlock(block)
+			}
+			result, _ = program.EvalBlock(block)
+		}
+
+		assignment := decode(uint64(coded), vars, len(vars))
+		values := make([]bool, len(columns))
+		for i := range programs {
+			values[i] = (results[i]>>bit)&1 == 1
+		}
+		t.Rows = append(t.Rows, Row{Assignment: assignment, Values: values, Result: (result>>bit)&1 == 1})
+	}
+	return t, nil
+}
+
+// header returns the column titles of the table, formatted in the given style.
+func (t *TruthTable) header(style Style) []string {
+	header := make([]string, 0, len(t.Vars)+len(t.Columns)+1)
+	header = append(header, t.Vars...)
+	for _, column := range t.Columns {
+		header = append(header, Format(column, style))
+	}
+	return append(header, Format(t.Formula, style))
+}
+
+// cells returns the values of the given row as 1 and 0.
+func (t *TruthTable) cells(row Row) []string {
+	cells := make([]string, 0, len(t.Vars)+len(row.Values)+1)
+	for _, name := range t.Vars {
+		cells = append(cells, bit(row.Assignment[name]))
+	}
+	for _, value := range row.Values {
+		cells = append(cells, bit(value))
+	}
+	return append(cells, bit(row.Result))
+}
+
+func bit(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// String renders the table as plain text with aligned columns.
+func (t *TruthTable) String() string {
+	header := t.header(MinimalStyle)
+	widths := make([]int, len(header))
+	for i, title := range header {
+		widths[i] = len([]rune(title))
+	}
+
+	var sb strings.Builder
+	writeLine := func(cells []string) {
+		for i, cell := range cells {
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+			sb.WriteString(cell + strings.Repeat(" ", widths[i]-len([]rune(cell))))
+		}
+		sb.WriteString("\n")
+	}
+
+	writeLine(header)
+	for i, width := range widths {
+		if i > 0 {
+			sb.WriteString("-+-")
+		}
+		sb.WriteString(strings.Repeat("-", width))
+	}
+	sb.WriteString("\n")
+	for _, row := range t.Rows {
+		writeLine(t.cells(row))
+	}
+	return sb.String()
+}
+
+// Markdown renders the table as a Markdown table.
+func (t *TruthTable) Markdown() string {
+	var sb strings.Builder
+	writeLine := func(cells []string) {
+		sb.WriteString("|")
+		for _, cell := range cells {
+			sb.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+		}
+		sb.WriteString("\n")
+	}
+
+	header := t.header(MinimalStyle)
+	writeLine(header)
+	sb.WriteString("|")
+	for range header {
+		sb.WriteString(" --- |")
+	}
+	sb.WriteString("\n")
+	for _, row := range t.Rows {
+		writeLine(t.cells(row))
+	}
+	return sb.String()
+}
+
+// CSV renders the table as comma-separated values with a header line.
+func (t *TruthTable) CSV() string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	// writing to a bytes.Buffer never fails
+	_ = w.Write(t.header(ASCIIStyle))
+	for _, row := range t.Rows {
+		_ = w.Write(t.cells(row))
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// LaTeX renders the table as LaTeX tabular environment.
+func (t *TruthTable) LaTeX() string {
+	style := LaTeXStyle
+	style.Minimal = true
+	header := t.header(style)
+
+	var sb strings.Builder
+	sb.WriteString(`\begin{tabular}{` + strings.Repeat("c", len(t.Vars)) + "|" +
+		strings.Repeat("c", len(t.Columns)+1) + "}\n")
+	for i, title := range header {
+		header[i] = "$" + title + "$"
+	}
+	sb.WriteString(strings.Join(header, " & ") + ` \\` + "\n")
+	sb.WriteString(`\hline` + "\n")
+	for _, row := range t.Rows {
+		sb.WriteString(strings.Join(t.cells(row), " & ") + ` \\` + "\n")
+	}
+	sb.WriteString(`\end{tabular}` + "\n")
+	return sb.String()
+}
+
+// compileRowMajor compiles f such that the first variable is the most significant bit of
+// the code of an assignment, i.e., enumerating the codes yields the rows of a truth table
+// in textbook order.
+func compileRowMajor(f LogicNode, vars []string) (*Program, error) {
+	reversed := make([]string, len(vars))
+	for i, name := range vars {
+		reversed[len(vars)-1-i] = name
+	}
+	return CompileWith(f, reversed)
+}
+
+// decode returns the assignment of the first k variables that is encoded in the code of
+// a row-major program of the variables (see compileRowMajor).
+func decode(code uint64, vars []string, k int) Assignment {
+	assignment := make(Assignment, k)
+	for i, name := range vars[:k] {
+		assignment[name] = (code>>(len(vars)-1-i))&1 == 1
+	}
+	return assignment
+}
@@ -0,0 +1,115 @@
+package bruteforce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/dmholtz/logo"
+)
+
+func TestNewTruthTable(t *testing.T) {
+	t.Run("rows in binary order", func(t *testing.T) {
+		table, err := NewTruthTable(Implies(Var("B"), Var("A")))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "B"}, table.Vars)
+		assert.Empty(t, table.Columns)
+		assert.Len(t, table.Rows, 4)
+		expected := []Row{
+			{Assignment{"A": false, "B": false}, []bool{}, true},
+			{Assignment{"A": false, "B": true}, []bool{}, false},
+			{Assignment{"A": true, "B": false}, []bool{}, true},
+			{Assignment{"A": true, "B": true}, []bool{}, true},
+		}
+		assert.Equal(t, expected, table.Rows)
+	})
+	t.Run("constant formula has one row", func(t *testing.T) {
+		table, err := NewTruthTable(Top())
+		assert.NoError(t, err)
+		assert.Empty(t, table.Vars)
+		assert.Equal(t, []Row{{Assignment{}, []bool{}, true}}, table.Rows)
+	})
+	t.Run("large formula is rejected", func(t *testing.T) {
+		clauses := []LogicNode{}
+		for i := 0; i < 21; i++ {
+			clauses = append(clauses, Var(fmt.Sprintf("x%d", i+1)))
+		}
+		_, err := NewTruthTable(NewConjunction(clauses...))
+		assert.ErrorIs(t, err, ErrTooManyVariables)
+	})
+}
+
+func TestNewTruthTableWithSubformulas(t *testing.T) {
+	t.Run("distinct compound subformulas in evaluation order", func(t *testing.T) {
+		f := MustParse("(!A & B) | !A")
+		table, err := NewTruthTableWithSubformulas(f)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"!A", "(!A & B)"}, []string{table.Columns[0].String(), table.Columns[1].String()})
+		assert.Len(t, table.Columns, 2)
+		for _, row := range table.Rows {
+			assert.Equal(t, !row.Assignment["A"], row.Values[0])
+			assert.Equal(t, !row.Assignment["A"] && row.Assignment["B"], row.Values[1])
+			assert.Equal(t, f.Eval(row.Assignment), row.Result)
+		}
+	})
+}
+
+func TestTruthTableRenderers(t *testing.T) {
+	table, err := NewTruthTableWithSubformulas(MustParse("!A | B"))
+	assert.NoError(t, err)
+	t.Run("plain text", func(t *testing.T) {
+		expected := "" +
+			"A | B | !A | !A | B\n" +
+			"--+---+----+-------\n" +
+			"0 | 0 | 1  | 1     \n" +
+			"0 | 1 | 1  | 1     \n" +
+			"1 | 0 | 0  | 0     \n" +
+			"1 | 1 | 0  | 1     \n"
+		assert.Equal(t, expected, table.String())
+	})
+	t.Run("markdown", func(t *testing.T) {
+		expected := "" +
+			"| A | B | !A | !A \\| B |\n" +
+			"| --- | --- | --- | --- |\n" +
+			"| 0 | 0 | 1 | 1 |\n" +
+			"| 0 | 1 | 1 | 1 |\n" +
+			"| 1 | 0 | 0 | 0 |\n" +
+			"| 1 | 1 | 0 | 1 |\n"
+		assert.Equal(t, expected, table.Markdown())
+	})
+	t.Run("csv", func(t *testing.T) {
+		expected := "A,B,!A,(!A | B)\n0,0,1,1\n0,1,1,1\n1,0,0,0\n1,1,0,1\n"
+		assert.Equal(t, expected, table.CSV())
+	})
+	t.Run("latex", func(t *testing.T) {
+		expected := "" +
+			"\\begin{tabular}{cc|cc}\n" +
+			"$A$ & $B$ & $\\neg A$ & $\\neg A \\lor B$ \\\\\n" +
+			"\\hline\n" +
+			"0 & 0 & 1 & 1 \\\\\n" +
+			"0 & 1 & 1 & 1 \\\\\n" +
+			"1 & 0 & 0 & 0 \\\\\n" +
+			"1 & 1 & 0 & 1 \\\\\n" +
+			"\\end{tabular}\n"
+		assert.Equal(t, expected, table.LaTeX())
+	})
+}
+
+func TestTruthTableBlocks(t *testing.T) {
+	t.Run("rows of many variables agree with Eval", func(t *testing.T) {
+		f := MustParse("(A ^ B ^ C) -> ((D | E) & (F <-> G))")
+		table, err := NewTruthTableWithSubformulas(f)
+		assert.NoError(t, err)
+		assert.Len(t, table.Rows, 128)
+		for r, row := range table.Rows {
+			for i, name := range table.Vars {
+				assert.Equal(t, (r>>(len(table.Vars)-1-i))&1 == 1, row.Assignment[name])
+			}
+			for i, column := range table.Columns {
+				assert.Equal(t, column.Eval(row.Assignment), row.Values[i])
+			}
+			assert.Equal(t, f.Eval(row.Assignment), row.Result)
+		}
+	})
+}
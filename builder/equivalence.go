@@ -34,16 +34,18 @@ func NewEquivalentFormulaBuilder(numVariables int, numOperators int) *Equivalent
 }
 
 // Question() returns a random formula as a reference for later calls to Equivalent() and NotEquivalent()
+//
+// Each call returns a fresh copy, so modifying the result does not affect other results of the builder.
 func (efb *EquivalentFormulaBuilder) Question() LogicNode {
-	return And(efb.Appendix, efb.BaseFormula)
+	return And(Clone(efb.Appendix), Clone(efb.BaseFormula))
 }
 
 // Equivalent() returns a formula that is equivalent to the reference formula
 func (efb *EquivalentFormulaBuilder) Equivalent() LogicNode {
-	return And(efb.BaseFormula, efb.Appendix)
+	return And(Clone(efb.BaseFormula), Clone(efb.Appendix))
 }
 
 // NotEquivalent() returns a formula that is not equivalent to the reference formula
 func (efb *EquivalentFormulaBuilder) NotEquivalent() LogicNode {
-	return Or(efb.BaseFormula, Not(efb.Appendix))
+	return Or(Clone(efb.BaseFormula), Not(Clone(efb.Appendix)))
 }
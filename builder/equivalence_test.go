@@ -3,7 +3,9 @@ package builder
 import (
 	"testing"
 
+	. "github.com/dmholtz/logo"
 	bf "github.com/dmholtz/logo/brute_force"
+	"github.com/dmholtz/logo/scrambler"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +43,24 @@ func TestEquivalent(t *testing.T) {
 	})
 }
 
+func TestBuilderResultsAreIndependent(t *testing.T) {
+	t.Run("scrambling the question leaves the base formula unchanged", func(t *testing.T) {
+		builder := NewEquivalentFormulaBuilder(4, 6)
+		base := builder.BaseFormula.String()
+
+		question := builder.Question()
+		scrambler.Traverse(question, func(f LogicNode) (LogicNode, bool) {
+			if v, ok := f.(*Variable); ok {
+				v.Name = "Z"
+			}
+			return f, true
+		})
+
+		assert.Equal(t, base, builder.BaseFormula.String())
+		assert.NotContains(t, builder.Equivalent().Scope(), "Z")
+	})
+}
+
 func TestNotEquivalent(t *testing.T) {
 	t.Run("NotEquivalent() returns a formula that is not equivalent to the reference formula", func(t *testing.T) {
 		builder := NewEquivalentFormulaBuilder(5, 6)
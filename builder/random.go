@@ -7,8 +7,15 @@ import (
 	. "github.com/dmholtz/logo"
 )
 
+// BasicOperators are the binary operators AND, OR, IMPLIES and IFF.
+var BasicOperators = []OpType{AndOp, OrOp, IfOp, IffOp}
+
+// ExtendedOperators are the BasicOperators plus XOR, NAND, NOR and the converse implication.
+var ExtendedOperators = []OpType{AndOp, OrOp, IfOp, IffOp, XorOp, NandOp, NorOp, ImpliedByOp}
+
 type RandomFormulaBuilder struct {
-	Scope []string
+	Scope     []string
+	Operators []OpType // binary operators to choose from, BasicOperators by default
 }
 
 func NewRandomFormulaBuilder(numVariables int) *RandomFormulaBuilder {
@@ -18,15 +25,19 @@ func NewRandomFormulaBuilder(numVariables int) *RandomFormulaBuilder {
 
 	scope := BuildScope(numVariables)
 	return &RandomFormulaBuilder{
-		Scope: scope,
+		Scope:     scope,
+		Operators: BasicOperators,
 	}
 }
 
-// Randomly returns a unary or a binary operator
-func randomOperator() LogicNode {
-	opType := rand.Intn(5)
-	if opType < 4 {
-		return &BinaryOp{Op: OpType(opType)}
+// Randomly returns a unary or one of the builder's binary operators
+func (rfb *RandomFormulaBuilder) randomOperator() LogicNode {
+	if len(rfb.Operators) == 0 {
+		panic("Operators must not be empty")
+	}
+	opIdx := rand.Intn(len(rfb.Operators) + 1)
+	if opIdx < len(rfb.Operators) {
+		return &BinaryOp{Op: rfb.Operators[opIdx]}
 	} else {
 		return &NotOp{}
 	}
@@ -44,36 +55,24 @@ func (rfb *RandomFormulaBuilder) Build(numOperators int) LogicNode {
 	// choose random operators
 	operators := make([]LogicNode, 0)
 	for i := 0; i < numOperators; i++ {
-		operators = append(operators, randomOperator())
+		operators = append(operators, rfb.randomOperator())
 	}
 
-	// grow the formula tree to the right
-	formula := operators[0]
-	op := formula
-	for i := 1; i < numOperators; i++ {
-		switch op1 := op.(type) {
-		case *BinaryOp:
-			op1.X = rfb.RandomVariable()
-			op1.Y = operators[i]
-			op = op1.Y
-		case *NotOp:
-			op1.X = operators[i]
-			op = op1.X
-		default:
-			panic(fmt.Sprintf("Unsupported operator type=%T of operator=%s", op1, op1))
-		}
-	}
+	return rfb.grow(operators)
+}
 
-	// assign variables to the leaves
-	switch op1 := op.(type) {
-	case *BinaryOp:
-		op1.X = rfb.RandomVariable()
-		op1.Y = rfb.RandomVariable()
-	case *NotOp:
-		op1.X = rfb.RandomVariable()
-	default:
-		panic(fmt.Sprintf("Unsupported operator type=%T of operator=%s", op1, op1))
+// grow nests the operators such that the formula tree grows to the right: the last
+// child of each operator is the next operator, all other children are random variables.
+func (rfb *RandomFormulaBuilder) grow(operators []LogicNode) LogicNode {
+	children := Children(operators[0])
+	for i := range children {
+		children[i] = rfb.RandomVariable()
 	}
-
-	return formula
+	if len(operators) > 1 {
+		if len(children) == 0 {
+			panic(fmt.Sprintf("Unsupported operator type=%T of operator=%s", operators[0], operators[0]))
+		}
+		children[len(children)-1] = rfb.grow(operators[1:])
+	}
+	return WithChildren(operators[0], children)
 }
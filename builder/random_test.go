@@ -3,6 +3,8 @@ package builder
 import (
 	"testing"
 
+	. "github.com/dmholtz/logo"
+	"github.com/dmholtz/logo/scrambler"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,4 +24,22 @@ func TestBuildRandom(t *testing.T) {
 		builder := NewRandomFormulaBuilder(3)
 		_ = builder.Build(10)
 	})
+
+	t.Run("Build() only uses the builder's operators", func(t *testing.T) {
+		builder := NewRandomFormulaBuilder(3)
+		builder.Operators = []OpType{XorOp, NandOp}
+		f := builder.Build(20)
+		scrambler.Traverse(f, func(node LogicNode) (LogicNode, bool) {
+			if binaryOp, ok := node.(*BinaryOp); ok {
+				assert.Contains(t, builder.Operators, binaryOp.Op)
+			}
+			return node, false
+		})
+	})
+
+	t.Run("Build() panics without operators", func(t *testing.T) {
+		builder := NewRandomFormulaBuilder(3)
+		builder.Operators = nil
+		assert.Panics(t, func() { builder.Build(3) })
+	})
 }
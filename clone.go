@@ -0,0 +1,28 @@
+package logo
+
+import "fmt"
+
+// Clone returns a deep copy of the formula f that shares no nodes with f.
+func Clone(f LogicNode) LogicNode {
+	switch f1 := f.(type) {
+	case *Variable:
+		return &Variable{Name: f1.Name}
+	case Leaf:
+		return f1
+	case *NotOp:
+		return &NotOp{X: Clone(f1.X)}
+	case *BinaryOp:
+		return &BinaryOp{X: Clone(f1.X), Y: Clone(f1.Y), Op: f1.Op}
+	case *NaryOp:
+		var clauses []LogicNode
+		if f1.Clauses != nil {
+			clauses = make([]LogicNode, len(f1.Clauses))
+			for i, clause := range f1.Clauses {
+				clauses[i] = Clone(clause)
+			}
+		}
+		return &NaryOp{Clauses: clauses, Op: f1.Op}
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
@@ -0,0 +1,26 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("clone is equal to the original", func(t *testing.T) {
+		f := Implies(NewConjunction(Var("A"), Not(Var("B")), Top()), Iff(Var("C"), NewDisjunction()))
+		assert.Equal(t, f, Clone(f))
+		assert.True(t, Equal(f, Clone(f)))
+	})
+	t.Run("modifying the clone leaves the original unchanged", func(t *testing.T) {
+		f := And(Not(Var("A")), NewDisjunction(Var("B"), Var("C")))
+		clone := Clone(f).(*BinaryOp)
+
+		clone.Op = OrOp
+		clone.X.(*NotOp).X.(*Variable).Name = "Z"
+		clone.Y.(*NaryOp).Clauses[0] = Bottom()
+
+		assert.Equal(t, "(!A & (B | C))", f.String())
+		assert.Equal(t, "(!Z | (false | C))", clone.String())
+	})
+}
@@ -0,0 +1,200 @@
+package logo
+
+import "fmt"
+
+// Program is a formula compiled into a flat sequence of instructions for a stack machine.
+// Variables are referred to by their index in Vars, which makes the evaluation much faster
+// than Eval, since neither interface calls nor map lookups are involved. A Program is
+// immutable and can be evaluated concurrently.
+type Program struct {
+	vars     []string
+	code     []instruction
+	maxStack int
+}
+
+type opcode uint8
+
+const (
+	opVar    opcode = iota // push the value of the variable arg
+	opConst                // push the value arg != 0
+	opNot                  // negate the top of the stack
+	opBinary               // pop y and x, push x op y
+	opNary                 // pop arg operands, push their conjunction, disjunction or parity
+)
+
+type instruction struct {
+	code opcode
+	op   OpType
+	arg  int
+}
+
+// Compile compiles the formula f. The variables of the program are the variables of f
+// in natural sort order (see Vars).
+func Compile(f LogicNode) *Program {
+	// the variables of f are bound by construction
+	p, _ := CompileWith(f, Vars(f))
+	return p
+}
+
+// CompileWith compiles the formula f such that the variables of the program are vars, in
+// this order. If a variable of f is missing in vars, the error wraps ErrUnboundVariable.
+func CompileWith(f LogicNode, vars []string) (*Program, error) {
+	p := &Program{vars: make([]string, len(vars))}
+	copy(p.vars, vars)
+	index := make(map[string]int, len(vars))
+	for i, name := range vars {
+		index[name] = i
+	}
+	if err := p.compile(f, index, 0); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// compile appends the instructions of f given that the stack holds height values.
+func (p *Program) compile(f LogicNode, index map[string]int, height int) error {
+	if height+1 > p.maxStack {
+		p.maxStack = height + 1
+	}
+	switch f1 := f.(type) {
+	case *Variable:
+		i, ok := index[f1.Name]
+		if !ok {
+			return fmt.Errorf("%w: %s is missing in the variables of the program", ErrUnboundVariable, f1.Name)
+		}
+		p.code = append(p.code, instruction{code: opVar, arg: i})
+	case Leaf:
+		arg := 0
+		if f1 {
+			arg = 1
+		}
+		p.code = append(p.code, instruction{code: opConst, arg: arg})
+	case *NotOp:
+		if err := p.compile(f1.X, index, height); err != nil {
+			return err
+		}
+		p.code = append(p.code, instruction{code: opNot})
+	case *BinaryOp:
+		if err := p.compile(f1.X, index, height); err != nil {
+			return err
+		}
+		if err := p.compile(f1.Y, index, height+1); err != nil {
+			return err
+		}
+		p.code = append(p.code, instruction{code: opBinary, op: f1.Op})
+	case *NaryOp:
+		// validate the operator at compile time
+		emptyNaryValue(f1.Op)
+		for i, clause := range f1.Clauses {
+			if err := p.compile(clause, index, height+i); err != nil {
+				return err
+			}
+		}
+		p.code = append(p.code, instruction{code: opNary, op: f1.Op, arg: len(f1.Clauses)})
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+	return nil
+}
+
+// Vars returns the variable names of the program: the i-th variable is bound to the
+// i-th value passed to Eval or to the i-th bit passed to EvalBits.
+func (p *Program) Vars() []string {
+	vars := make([]string, len(p.vars))
+	copy(vars, p.vars)
+	return vars
+}
+
+// Eval evaluates the program, where values[i] is the value of the i-th variable. If there
+// are fewer values than variables, the error wraps ErrUnboundVariable.
+func (p *Program) Eval(values []bool) (bool, error) {
+	if len(values) < len(p.vars) {
+		return false, fmt.Errorf("%w: program expects %d values, got %d", ErrUnboundVariable, len(p.vars), len(values))
+	}
+	return p.run(func(i int) uint64 {
+		if values[i] {
+			return ^uint64(0)
+		}
+		return 0
+	})&1 == 1, nil
+}
+
+// EvalBits evaluates the program, where bit i (counting from the least significant bit)
+// is the value of the i-th variable. It supports programs with up to 64 variables; the
+// error wraps ErrTooManyVariables otherwise.
+func (p *Program) EvalBits(bits uint64) (bool, error) {
+	if len(p.vars) > 64 {
+		return false, fmt.Errorf("%w for a bit vector: %d > 64", ErrTooManyVariables, len(p.vars))
+	}
+	return p.run(func(i int) uint64 { return -((bits >> i) & 1) })&1 == 1, nil
+}
+
+// run evaluates the program bit-parallel, where value(i) returns the word of the i-th variable.
+func (p *Program) run(value func(int) uint64) uint64 {
+	var buf [64]uint64
+	stack := buf[:0]
+	if p.maxStack > len(buf) {
+		stack = make([]uint64, 0, p.maxStack)
+	}
+
+	for _, in := range p.code {
+		switch in.code {
+		case opVar:
+			stack = append(stack, value(in.arg))
+		case opConst:
+			stack = append(stack, -uint64(in.arg))
+		case opNot:
+			stack[len(stack)-1] = ^stack[len(stack)-1]
+		case opBinary:
+			x, y := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1] = evalBinary(in.op, x, y)
+		case opNary:
+			operands := stack[len(stack)-in.arg:]
+			result := evalNary(in.op, operands)
+			stack = append(stack[:len(stack)-in.arg], result)
+		}
+	}
+	return stack[0]
+}
+
+func evalBinary(op OpType, x, y uint64) uint64 {
+	switch op {
+	case AndOp:
+		return x & y
+	case OrOp:
+		return x | y
+	case IfOp:
+		return ^x | y
+	case IffOp:
+		return ^(x ^ y)
+	case XorOp:
+		return x ^ y
+	case NandOp:
+		return ^(x & y)
+	case NorOp:
+		return ^(x | y)
+	case ImpliedByOp:
+		return x | ^y
+	default:
+		panic(fmt.Sprintf("Unknown OpType=%d", op))
+	}
+}
+
+func evalNary(op OpType, operands []uint64) uint64 {
+	var result uint64
+	if emptyNaryValue(op) {
+		result = ^uint64(0)
+	}
+	for _, operand := range operands {
+		switch op {
+		case AndOp:
+			result &= operand
+		case OrOp:
+			result |= operand
+		case XorOp:
+			result ^= operand
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	t.Run("variables are sorted", func(t *testing.T) {
+		p := Compile(MustParse("C & (A | B)"))
+		assert.Equal(t, []string{"A", "B", "C"}, p.Vars())
+	})
+	t.Run("agrees with Eval", func(t *testing.T) {
+		formulas := []LogicNode{
+			MustParse("((A ^ B) !& (C | !A)) <- (A !| (B -> C)) <-> (A <-> C)"),
+			NewConjunction(Var("A"), NewDisjunction(Var("B"), Not(Var("C")), Bottom()), NewExclusiveDisjunction(Var("A"), Var("B"), Var("C"))),
+			Implies(NewConjunction(), NewDisjunction()),
+			NewExclusiveDisjunction(),
+			Top(),
+		}
+		for _, f := range formulas {
+			p := Compile(f)
+			n := len(p.Vars())
+			for bits := uint64(0); bits < 1<<n; bits++ {
+				assignment := make(Assignment)
+				values := make([]bool, n)
+				for i, name := range p.Vars() {
+					values[i] = (bits>>i)&1 == 1
+					assignment[name] = values[i]
+				}
+				value, err := p.Eval(values)
+				assert.NoError(t, err)
+				assert.Equal(t, f.Eval(assignment), value, "%s with %v", f, assignment)
+				value, err = p.EvalBits(bits)
+				assert.NoError(t, err)
+				assert.Equal(t, f.Eval(assignment), value, "%s with %v", f, assignment)
+			}
+		}
+	})
+	t.Run("deep formulas", func(t *testing.T) {
+		f := Var("A")
+		for i := 0; i < 100; i++ {
+			f = Or(Var("B"), f)
+		}
+		f = Or(f, Var("A"))
+		p := Compile(f)
+		value, err := p.Eval([]bool{true, false})
+		assert.NoError(t, err)
+		assert.True(t, value)
+		value, err = p.Eval([]bool{false, false})
+		assert.NoError(t, err)
+		assert.False(t, value)
+	})
+	t.Run("CompileWith", func(t *testing.T) {
+		p, err := CompileWith(Implies(Var("A"), Var("B")), []string{"B", "X", "A"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"B", "X", "A"}, p.Vars())
+		value, err := p.EvalBits(0b100)
+		assert.NoError(t, err)
+		assert.False(t, value)
+		value, err = p.EvalBits(0b101)
+		assert.NoError(t, err)
+		assert.True(t, value)
+	})
+	t.Run("unbound variable", func(t *testing.T) {
+		_, err := CompileWith(And(Var("B"), Not(Var("A"))), []string{"B"})
+		assert.ErrorIs(t, err, ErrUnboundVariable)
+	})
+	t.Run("too few values", func(t *testing.T) {
+		_, err := Compile(And(Var("A"), Var("B"))).Eval([]bool{true})
+		assert.ErrorIs(t, err, ErrUnboundVariable)
+	})
+}
@@ -0,0 +1,79 @@
+package logo
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// SourcePos describes a location in the input of the parser.
+type SourcePos struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // column number in characters, starting at 1
+}
+
+func (p SourcePos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// sourcePosAt returns the SourcePos of the given byte offset in src.
+func sourcePosAt(src string, offset int) SourcePos {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	lineStart := strings.LastIndexByte(src[:offset], '\n') + 1
+	return SourcePos{
+		Offset: offset,
+		Line:   strings.Count(src[:offset], "\n") + 1,
+		Column: utf8.RuneCountInString(src[lineStart:offset]) + 1,
+	}
+}
+
+// SyntaxError describes why and where an input could not be parsed.
+type SyntaxError struct {
+	Pos      SourcePos // start of the offending input
+	End      SourcePos // position immediately after the offending input
+	Msg      string
+	Expected []string // tokens that would have been valid at Pos, if known
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %s: %s", e.Pos, e.Msg)
+}
+
+// ErrorList is a list of syntax errors in the order of their position in the input.
+type ErrorList []*SyntaxError
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	case 2:
+		return fmt.Sprintf("%s (and 1 more error)", l[0])
+	default:
+		return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+	}
+}
+
+// Err returns an error equivalent to this error list. If the list is empty, Err returns nil.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// ParseDiagnostics parses a formula like Parse, but does not stop at the first
+// syntax error. Instead, it recovers from the error and reports all syntax errors
+// found in the input, e.g., unbalanced parentheses, unknown operators, missing
+// operands or dangling negations.
+//
+// Along with the errors, ParseDiagnostics returns the partial formula that could be
+// recovered from the input: subformulas that cannot be parsed are left out. The
+// partial formula is nil if nothing could be recovered.
+func ParseDiagnostics(s string) (LogicNode, ErrorList) {
+	return ASCII.ParseDiagnostics(s)
+}
@@ -0,0 +1,75 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiagnostics(t *testing.T) {
+	t.Run("valid input yields no errors", func(t *testing.T) {
+		f, errs := ParseDiagnostics("(A & !B) -> C")
+		assert.Empty(t, errs)
+		assert.Nil(t, errs.Err())
+		assert.Equal(t, Implies(And(Var("A"), Not(Var("B"))), Var("C")), f)
+	})
+	t.Run("errors report line and column", func(t *testing.T) {
+		_, errs := ParseDiagnostics("(A & B)\n  | # C")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, SourcePos{Offset: 12, Line: 2, Column: 5}, errs[0].Pos)
+		assert.Equal(t, SourcePos{Offset: 13, Line: 2, Column: 6}, errs[0].End)
+	})
+	t.Run("unclosed parenthesis", func(t *testing.T) {
+		f, errs := ParseDiagnostics("(A & B")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 0, errs[0].Pos.Offset)
+		assert.Equal(t, []string{")"}, errs[0].Expected)
+		assert.Equal(t, And(Var("A"), Var("B")), f)
+	})
+	t.Run("unmatched closing parenthesis", func(t *testing.T) {
+		f, errs := ParseDiagnostics("A) & B")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 1, errs[0].Pos.Offset)
+		assert.Contains(t, errs[0].Expected, "end of input")
+		assert.Equal(t, And(Var("A"), Var("B")), f)
+	})
+	t.Run("unknown operator", func(t *testing.T) {
+		f, errs := ParseDiagnostics("A => B")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, "unknown operator \"=>\"", errs[0].Msg)
+		assert.Equal(t, []string{"&", "|", "->", "<->", "^", "!&", "!|", "<-"}, errs[0].Expected)
+		assert.Equal(t, Var("A"), f)
+	})
+	t.Run("unknown prefix operator is skipped", func(t *testing.T) {
+		f, errs := ParseDiagnostics("~A & B")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, And(Var("A"), Var("B")), f)
+	})
+	t.Run("dangling negation", func(t *testing.T) {
+		f, errs := ParseDiagnostics("A & !")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 4, errs[0].Pos.Offset)
+		assert.Equal(t, []string{"variable", "true", "false", "!", "("}, errs[0].Expected)
+		assert.Equal(t, Var("A"), f)
+	})
+	t.Run("missing operand", func(t *testing.T) {
+		f, errs := ParseDiagnostics("A & & B")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, "expected formula, found \"&\"", errs[0].Msg)
+		assert.Equal(t, And(Var("A"), Var("B")), f)
+	})
+	t.Run("several errors are reported at once", func(t *testing.T) {
+		f, errs := ParseDiagnostics("(A # B) & (C | !) & (D")
+		assert.Len(t, errs, 3)
+		assert.Equal(t, 3, errs[0].Pos.Offset)
+		assert.Equal(t, 15, errs[1].Pos.Offset)
+		assert.Equal(t, 20, errs[2].Pos.Offset)
+		assert.Equal(t, NewConjunction(Var("A"), Var("C"), Var("D")), f)
+		assert.Contains(t, errs.Error(), "(and 2 more errors)")
+	})
+	t.Run("nothing can be recovered", func(t *testing.T) {
+		f, errs := ParseDiagnostics(")")
+		assert.NotEmpty(t, errs)
+		assert.Nil(t, f)
+	})
+}
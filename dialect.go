@@ -0,0 +1,200 @@
+package logo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Dialect is a textual notation of propositional formulas that can be parsed
+// into LogicNodes.
+type Dialect interface {
+	// Name returns the unique name under which the dialect is registered.
+	Name() string
+	// ParseDiagnostics parses a formula and reports all syntax errors found in the input
+	// along with the partial formula that could be recovered (see ParseDiagnostics).
+	ParseDiagnostics(s string) (LogicNode, ErrorList)
+}
+
+var (
+	// ASCII is the dialect emitted by String(), e.g. (!A & B) -> (C <-> D).
+	ASCII Dialect = newInfixDialect("ascii", false,
+		[]spelling{{"!", tokNot}, {"&", tokAnd}, {"|", tokOr}, {"->", tokIf}, {"<->", tokIff},
+			{"^", tokXor}, {"!&", tokNand}, {"!|", tokNor}, {"<-", tokImpliedBy}, {"(", tokLParen}, {")", tokRParen}},
+		[]spelling{{"true", tokTrue}, {"false", tokFalse}})
+
+	// Unicode is the dialect of mathematical logic symbols, e.g. ¬A ∧ B → (C ↔ D).
+	Unicode Dialect = newInfixDialect("unicode", false,
+		[]spelling{{"¬", tokNot}, {"∧", tokAnd}, {"∨", tokOr}, {"→", tokIf}, {"⇒", tokIf}, {"↔", tokIff}, {"⇔", tokIff},
+			{"⊕", tokXor}, {"↑", tokNand}, {"⊼", tokNand}, {"↓", tokNor}, {"⊽", tokNor}, {"←", tokImpliedBy}, {"⇐", tokImpliedBy},
+			{"⊤", tokTrue}, {"⊥", tokFalse}, {"(", tokLParen}, {")", tokRParen}},
+		nil)
+
+	// Keyword is the dialect of case-insensitive English keywords, e.g. NOT A AND B IMPLIES (C IFF D).
+	// The keyword IF denotes the converse implication, i.e., A IF B means B IMPLIES A.
+	Keyword Dialect = newInfixDialect("keyword", true,
+		[]spelling{{"(", tokLParen}, {")", tokRParen}},
+		[]spelling{{"NOT", tokNot}, {"AND", tokAnd}, {"OR", tokOr}, {"IMPLIES", tokIf}, {"IFF", tokIff},
+			{"XOR", tokXor}, {"NAND", tokNand}, {"NOR", tokNor}, {"IF", tokImpliedBy}, {"TRUE", tokTrue}, {"FALSE", tokFalse}})
+
+	// Polish is the prefix notation of Łukasiewicz, e.g. CKNpqEqr.
+	Polish Dialect = polishDialect{}
+)
+
+var dialects = make(map[string]Dialect)
+
+func init() {
+	RegisterDialect(ASCII)
+	RegisterDialect(Unicode)
+	RegisterDialect(Keyword)
+	RegisterDialect(Polish)
+}
+
+// RegisterDialect makes a dialect available by its name. It panics if a dialect
+// with the same name has already been registered.
+func RegisterDialect(d Dialect) {
+	if _, ok := dialects[d.Name()]; ok {
+		panic(fmt.Sprintf("Dialect=%s is already registered", d.Name()))
+	}
+	dialects[d.Name()] = d
+}
+
+// LookupDialect returns the dialect registered under the given name.
+func LookupDialect(name string) (Dialect, bool) {
+	d, ok := dialects[name]
+	return d, ok
+}
+
+// Dialects returns the sorted names of all registered dialects.
+func Dialects() []string {
+	names := make([]string, 0, len(dialects))
+	for name := range dialects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseDialect parses a formula written in the dialect registered under the given name.
+// If the input is not a valid formula, ParseDialect returns the first *SyntaxError.
+func ParseDialect(name string, s string) (LogicNode, error) {
+	d, ok := LookupDialect(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown dialect %q", name)
+	}
+	f, errs := d.ParseDiagnostics(s)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return f, nil
+}
+
+// DetectDialect guesses the dialect of the input among the built-in dialects.
+// Input containing Unicode connectives is Unicode, input containing connective
+// keywords such as AND is Keyword, and input consisting of Polish connectives and
+// variables only that parses without errors is Polish. All other input is ASCII.
+func DetectDialect(s string) Dialect {
+	if strings.ContainsAny(s, "¬∧∨→⇒↔⇔⊕↑⊼↓⊽←⇐⊤⊥") {
+		return Unicode
+	}
+	kd := Keyword.(*infixDialect)
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool { return !isIdentPart(r) }) {
+		if kind, ok := kd.keyword(word); ok && kind != tokTrue && kind != tokFalse {
+			return Keyword
+		}
+	}
+	if isPolishCandidate(s) {
+		if _, errs := Polish.ParseDiagnostics(s); len(errs) == 0 {
+			return Polish
+		}
+	}
+	return ASCII
+}
+
+// ParseAuto parses a formula in the dialect returned by DetectDialect.
+func ParseAuto(s string) (LogicNode, error) {
+	return ParseDialect(DetectDialect(s).Name(), s)
+}
+
+// infixDialect is a dialect with prefix negation and infix binary operators, which
+// only differs from the ASCII dialect in the spelling of the tokens.
+type infixDialect struct {
+	name     string
+	foldCase bool       // keywords are case-insensitive
+	symbols  []spelling // sorted by decreasing length
+	keywords []spelling // matched against complete identifiers
+}
+
+func newInfixDialect(name string, foldCase bool, symbols, keywords []spelling) *infixDialect {
+	sorted := make([]spelling, len(symbols))
+	copy(sorted, symbols)
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i].text) > len(sorted[j].text) })
+	return &infixDialect{name: name, foldCase: foldCase, symbols: sorted, keywords: keywords}
+}
+
+func (d *infixDialect) Name() string {
+	return d.name
+}
+
+func (d *infixDialect) ParseDiagnostics(s string) (LogicNode, ErrorList) {
+	p := newParser(d, s)
+	f := p.parseFormula(0)
+	return f, p.errs
+}
+
+// keyword returns the token of the given identifier if it is a keyword of the dialect.
+func (d *infixDialect) keyword(ident string) (tokenKind, bool) {
+	for _, kw := range d.keywords {
+		if kw.text == ident || (d.foldCase && strings.EqualFold(kw.text, ident)) {
+			return kw.kind, true
+		}
+	}
+	return 0, false
+}
+
+// supports returns true iff the dialect has a spelling for the given token.
+func (d *infixDialect) supports(kind tokenKind) bool {
+	for _, sym := range d.symbols {
+		if sym.kind == kind {
+			return true
+		}
+	}
+	for _, kw := range d.keywords {
+		if kw.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// spell returns the preferred spelling of a token in the dialect.
+func (d *infixDialect) spell(kind tokenKind) string {
+	for _, sym := range d.symbols {
+		if sym.kind == kind {
+			return sym.text
+		}
+	}
+	for _, kw := range d.keywords {
+		if kw.kind == kind {
+			return kw.text
+		}
+	}
+	return kind.String()
+}
+
+// isPolishCandidate returns true iff s contains only characters of the Polish
+// dialect and at least one connective.
+func isPolishCandidate(s string) bool {
+	hasConnective := false
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(polishConnectives, r):
+			hasConnective = true
+		case unicode.IsSpace(r), r == '0', r == '1', isPolishVarStart(r), unicode.IsDigit(r):
+		default:
+			return false
+		}
+	}
+	return hasConnective
+}
@@ -0,0 +1,89 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialects(t *testing.T) {
+	expected := Implies(And(Not(Var("A")), Or(Var("B"), Var("C"))), Var("D"))
+
+	t.Run("built-in dialects are registered", func(t *testing.T) {
+		assert.Equal(t, []string{"ascii", "keyword", "polish", "unicode"}, Dialects())
+		d, ok := LookupDialect("unicode")
+		assert.True(t, ok)
+		assert.Equal(t, Unicode, d)
+	})
+	t.Run("registering a dialect twice panics", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterDialect(ASCII) })
+	})
+	t.Run("unknown dialect", func(t *testing.T) {
+		_, err := ParseDialect("klingon", "A")
+		assert.Error(t, err)
+	})
+	t.Run("ascii", func(t *testing.T) {
+		f, err := ParseDialect("ascii", "!A & (B | C) -> D")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f)
+	})
+	t.Run("unicode", func(t *testing.T) {
+		f, err := ParseDialect("unicode", "¬A ∧ (B ∨ C) → D")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f)
+
+		f, err = ParseDialect("unicode", "(A ⇔ ⊤) ↔ ⊥")
+		assert.NoError(t, err)
+		assert.Equal(t, Iff(Iff(Var("A"), Top()), Bottom()), f)
+	})
+	t.Run("keyword", func(t *testing.T) {
+		f, err := ParseDialect("keyword", "NOT A AND (B OR C) IMPLIES D")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f)
+
+		f, err = ParseDialect("keyword", "a iff True")
+		assert.NoError(t, err)
+		assert.Equal(t, Iff(Var("a"), Top()), f)
+	})
+	t.Run("additional connectives", func(t *testing.T) {
+		expected := ImpliedBy(Xor(Var("A"), Var("B")), Nor(Nand(Var("C"), Var("D")), Var("E")))
+		for _, s := range []string{"A ^ B <- C !& D !| E", "A ⊕ B ← C ↑ D ↓ E", "A XOR B IF C NAND D NOR E"} {
+			f, err := ParseAuto(s)
+			assert.NoError(t, err, s)
+			assert.Equal(t, expected, f, s)
+		}
+	})
+	t.Run("syntax errors are reported in the spelling of the dialect", func(t *testing.T) {
+		_, errs := Keyword.ParseDiagnostics("A AND NOT")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, []string{"variable", "TRUE", "FALSE", "NOT", "("}, errs[0].Expected)
+	})
+}
+
+func TestDetectDialect(t *testing.T) {
+	t.Run("unicode", func(t *testing.T) {
+		assert.Equal(t, Unicode, DetectDialect("¬A ∧ (B ∨ C) → D"))
+	})
+	t.Run("keyword", func(t *testing.T) {
+		assert.Equal(t, Keyword, DetectDialect("NOT A AND (B OR C) IMPLIES D"))
+		assert.Equal(t, Keyword, DetectDialect("rain implies wet"))
+	})
+	t.Run("polish", func(t *testing.T) {
+		assert.Equal(t, Polish, DetectDialect("CKpqNr"))
+	})
+	t.Run("ascii", func(t *testing.T) {
+		assert.Equal(t, ASCII, DetectDialect("!A & (B | C) -> D"))
+		assert.Equal(t, ASCII, DetectDialect("A"))
+		assert.Equal(t, ASCII, DetectDialect("Kp"))
+	})
+	t.Run("ParseAuto", func(t *testing.T) {
+		for _, s := range []string{"¬A ∧ B", "NOT A AND B", "!A & B"} {
+			f, err := ParseAuto(s)
+			assert.NoError(t, err)
+			assert.Equal(t, And(Not(Var("A")), Var("B")), f)
+		}
+		f, err := ParseAuto("KNpq")
+		assert.NoError(t, err)
+		assert.Equal(t, And(Not(Var("p")), Var("q")), f)
+	})
+}
@@ -0,0 +1,260 @@
+package logo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"sort"
+)
+
+// node tags distinguish the node types in hashes
+const (
+	hashVariable byte = iota + 1
+	hashLeaf
+	hashNot
+	hashBinary
+	hashNary
+	hashAC // associative and commutative operators modulo associativity and commutativity
+)
+
+// Equal returns true iff the formulas f and g are structurally equal, i.e., they
+// consist of the same node types with the same operators and variables in the same order.
+func Equal(f, g LogicNode) bool {
+	switch f1 := f.(type) {
+	case *Variable:
+		g1, ok := g.(*Variable)
+		return ok && f1.Name == g1.Name
+	case Leaf:
+		g1, ok := g.(Leaf)
+		return ok && f1 == g1
+	case *NotOp:
+		g1, ok := g.(*NotOp)
+		return ok && Equal(f1.X, g1.X)
+	case *BinaryOp:
+		g1, ok := g.(*BinaryOp)
+		return ok && f1.Op == g1.Op && Equal(f1.X, g1.X) && Equal(f1.Y, g1.Y)
+	case *NaryOp:
+		g1, ok := g.(*NaryOp)
+		if !ok || f1.Op != g1.Op || len(f1.Clauses) != len(g1.Clauses) {
+			return false
+		}
+		for i := range f1.Clauses {
+			if !Equal(f1.Clauses[i], g1.Clauses[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+// Hash returns a hash of the formula f that is stable across program runs.
+// Structurally equal formulas (see Equal) have the same hash.
+func Hash(f LogicNode) uint64 {
+	h := newHasher()
+	switch f1 := f.(type) {
+	case *Variable:
+		h.writeTag(hashVariable)
+		h.writeString(f1.Name)
+	case Leaf:
+		h.writeTag(hashLeaf)
+		h.writeBool(bool(f1))
+	case *NotOp:
+		h.writeTag(hashNot)
+		h.writeUint64(Hash(f1.X))
+	case *BinaryOp:
+		h.writeTag(hashBinary)
+		h.writeUint64(uint64(f1.Op))
+		h.writeUint64(Hash(f1.X))
+		h.writeUint64(Hash(f1.Y))
+	case *NaryOp:
+		h.writeTag(hashNary)
+		h.writeUint64(uint64(f1.Op))
+		h.writeUint64(uint64(len(f1.Clauses)))
+		for _, clause := range f1.Clauses {
+			h.writeUint64(Hash(clause))
+		}
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+	return h.sum()
+}
+
+// EqualModuloAC works like Equal but treats conjunctions, disjunctions and exclusive
+// disjunctions as associative and commutative: nested BinaryOps and NaryOps of the same operator
+// are flattened, and their operands are compared as multisets. For example,
+// (A & (B & C)) and (C & B & A) are equal modulo AC.
+func EqualModuloAC(f, g LogicNode) bool {
+	if op, ok := acOperator(f); ok {
+		if opG, ok := acOperator(g); !ok || op != opG {
+			return false
+		}
+		return equalMultisets(flattenAC(f, op, nil), flattenAC(g, op, nil))
+	}
+
+	switch f1 := f.(type) {
+	case *NotOp:
+		g1, ok := g.(*NotOp)
+		return ok && EqualModuloAC(f1.X, g1.X)
+	case *BinaryOp:
+		g1, ok := g.(*BinaryOp)
+		return ok && f1.Op == g1.Op && EqualModuloAC(f1.X, g1.X) && EqualModuloAC(f1.Y, g1.Y)
+	case *NaryOp:
+		g1, ok := g.(*NaryOp)
+		if !ok || f1.Op != g1.Op || len(f1.Clauses) != len(g1.Clauses) {
+			return false
+		}
+		for i := range f1.Clauses {
+			if !EqualModuloAC(f1.Clauses[i], g1.Clauses[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return Equal(f, g)
+	}
+}
+
+// HashModuloAC returns a hash of the formula f that is stable across program runs.
+// Formulas that are equal modulo AC (see EqualModuloAC) have the same hash.
+func HashModuloAC(f LogicNode) uint64 {
+	if op, ok := acOperator(f); ok {
+		operands := flattenAC(f, op, nil)
+		hashes := make([]uint64, len(operands))
+		for i, operand := range operands {
+			hashes[i] = HashModuloAC(operand)
+		}
+		sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+
+		h := newHasher()
+		h.writeTag(hashAC)
+		h.writeUint64(uint64(op))
+		h.writeUint64(uint64(len(hashes)))
+		for _, operandHash := range hashes {
+			h.writeUint64(operandHash)
+		}
+		return h.sum()
+	}
+
+	h := newHasher()
+	switch f1 := f.(type) {
+	case *NotOp:
+		h.writeTag(hashNot)
+		h.writeUint64(HashModuloAC(f1.X))
+	case *BinaryOp:
+		h.writeTag(hashBinary)
+		h.writeUint64(uint64(f1.Op))
+		h.writeUint64(HashModuloAC(f1.X))
+		h.writeUint64(HashModuloAC(f1.Y))
+	case *NaryOp:
+		h.writeTag(hashNary)
+		h.writeUint64(uint64(f1.Op))
+		h.writeUint64(uint64(len(f1.Clauses)))
+		for _, clause := range f1.Clauses {
+			h.writeUint64(HashModuloAC(clause))
+		}
+	default:
+		return Hash(f)
+	}
+	return h.sum()
+}
+
+// acOperator returns the operator of f if f is a conjunction, disjunction or exclusive disjunction.
+func acOperator(f LogicNode) (OpType, bool) {
+	switch f1 := f.(type) {
+	case *BinaryOp:
+		if f1.Op == AndOp || f1.Op == OrOp || f1.Op == XorOp {
+			return f1.Op, true
+		}
+	case *NaryOp:
+		if f1.Op == AndOp || f1.Op == OrOp || f1.Op == XorOp {
+			return f1.Op, true
+		}
+	}
+	return 0, false
+}
+
+// flattenAC appends the operands of nested BinaryOps and NaryOps of the given operator to operands.
+func flattenAC(f LogicNode, op OpType, operands []LogicNode) []LogicNode {
+	switch f1 := f.(type) {
+	case *BinaryOp:
+		if f1.Op == op {
+			operands = flattenAC(f1.X, op, operands)
+			return flattenAC(f1.Y, op, operands)
+		}
+	case *NaryOp:
+		if f1.Op == op {
+			for _, clause := range f1.Clauses {
+				operands = flattenAC(clause, op, operands)
+			}
+			return operands
+		}
+	}
+	return append(operands, f)
+}
+
+// equalMultisets returns true iff fs and gs contain the same formulas modulo AC with the same multiplicity.
+func equalMultisets(fs, gs []LogicNode) bool {
+	if len(fs) != len(gs) {
+		return false
+	}
+	buckets := make(map[uint64][]LogicNode)
+	for _, g := range gs {
+		key := HashModuloAC(g)
+		buckets[key] = append(buckets[key], g)
+	}
+	for _, f := range fs {
+		key := HashModuloAC(f)
+		bucket := buckets[key]
+		found := false
+		for i, g := range bucket {
+			if EqualModuloAC(f, g) {
+				buckets[key] = append(bucket[:i], bucket[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hasher computes 64-bit FNV-1a hashes.
+type hasher struct {
+	buf [8]byte
+	h   hash.Hash64
+}
+
+func newHasher() *hasher {
+	return &hasher{h: fnv.New64a()}
+}
+
+func (h *hasher) writeTag(t byte) {
+	h.h.Write([]byte{t})
+}
+
+func (h *hasher) writeBool(b bool) {
+	if b {
+		h.writeTag(1)
+	} else {
+		h.writeTag(0)
+	}
+}
+
+func (h *hasher) writeUint64(v uint64) {
+	binary.LittleEndian.PutUint64(h.buf[:], v)
+	h.h.Write(h.buf[:])
+}
+
+func (h *hasher) writeString(s string) {
+	h.writeUint64(uint64(len(s)))
+	h.h.Write([]byte(s))
+}
+
+func (h *hasher) sum() uint64 {
+	return h.h.Sum64()
+}
@@ -0,0 +1,68 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	t.Run("structurally equal formulas", func(t *testing.T) {
+		f := Implies(NewConjunction(Var("A"), Not(Var("B")), Top()), Iff(Var("C"), Bottom()))
+		g := Implies(NewConjunction(Var("A"), Not(Var("B")), Top()), Iff(Var("C"), Bottom()))
+		assert.True(t, Equal(f, g))
+		assert.Equal(t, Hash(f), Hash(g))
+	})
+	t.Run("structurally different formulas", func(t *testing.T) {
+		pairs := [][2]LogicNode{
+			{Var("A"), Var("B")},
+			{Top(), Bottom()},
+			{Var("A"), Not(Var("A"))},
+			{And(Var("A"), Var("B")), Or(Var("A"), Var("B"))},
+			{And(Var("A"), Var("B")), And(Var("B"), Var("A"))},
+			{And(Var("A"), Var("B")), NewConjunction(Var("A"), Var("B"))},
+			{NewConjunction(Var("A"), Var("B")), NewConjunction(Var("A"), Var("B"), Var("B"))},
+			{NewConjunction(), Top()},
+		}
+		for _, pair := range pairs {
+			assert.False(t, Equal(pair[0], pair[1]), "%s == %s", pair[0], pair[1])
+			assert.NotEqual(t, Hash(pair[0]), Hash(pair[1]), "%s == %s", pair[0], pair[1])
+		}
+	})
+	t.Run("hashes are stable", func(t *testing.T) {
+		assert.Equal(t, uint64(0x466e85b92d126dd5), Hash(And(Var("A"), Not(Var("B")))))
+	})
+	t.Run("unknown node type panics", func(t *testing.T) {
+		assert.Panics(t, func() { Equal(nil, Var("A")) })
+		assert.Panics(t, func() { Hash(nil) })
+	})
+}
+
+func TestEqualModuloAC(t *testing.T) {
+	t.Run("commutativity and associativity", func(t *testing.T) {
+		f := And(Var("A"), And(Var("B"), Var("C")))
+		g := NewConjunction(Var("C"), Var("B"), Var("A"))
+		assert.True(t, EqualModuloAC(f, g))
+		assert.Equal(t, HashModuloAC(f), HashModuloAC(g))
+	})
+	t.Run("nested subformulas", func(t *testing.T) {
+		f := Not(Implies(Or(Var("A"), Var("B")), NewDisjunction(Var("C"), Var("D"), Var("E"))))
+		g := Not(Implies(Or(Var("B"), Var("A")), Or(Var("E"), Or(Var("D"), Var("C")))))
+		assert.True(t, EqualModuloAC(f, g))
+		assert.Equal(t, HashModuloAC(f), HashModuloAC(g))
+	})
+	t.Run("multiplicity matters", func(t *testing.T) {
+		assert.False(t, EqualModuloAC(NewConjunction(Var("A"), Var("A"), Var("B")), NewConjunction(Var("A"), Var("B"), Var("B"))))
+	})
+	t.Run("different operators", func(t *testing.T) {
+		assert.False(t, EqualModuloAC(And(Var("A"), Var("B")), Or(Var("B"), Var("A"))))
+		assert.False(t, EqualModuloAC(And(Var("A"), Or(Var("B"), Var("C"))), NewConjunction(Var("A"), Var("B"), Var("C"))))
+	})
+	t.Run("exclusive disjunction is associative and commutative", func(t *testing.T) {
+		assert.True(t, EqualModuloAC(Xor(Var("A"), Xor(Var("B"), Var("C"))), NewExclusiveDisjunction(Var("C"), Var("A"), Var("B"))))
+		assert.False(t, EqualModuloAC(Nand(Var("A"), Nand(Var("B"), Var("C"))), Nand(Nand(Var("A"), Var("B")), Var("C"))))
+	})
+	t.Run("implication is not commutative", func(t *testing.T) {
+		assert.False(t, EqualModuloAC(Implies(Var("A"), Var("B")), Implies(Var("B"), Var("A"))))
+	})
+}
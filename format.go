@@ -0,0 +1,234 @@
+package logo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Style configures how Format prints a formula.
+type Style struct {
+	Not    string            // prefix of a negated formula
+	Ops    map[OpType]string // symbols of the binary and n-ary operators
+	Top    string            // symbol of Top()
+	Bottom string            // symbol of Bottom()
+
+	// Minimal omits all parentheses that are redundant according to Precedence.
+	// Otherwise, every binary and n-ary operator is parenthesized like in String().
+	Minimal bool
+	// Precedence is used to omit parentheses if Minimal is set. If nil, DefaultPrecedence is used.
+	Precedence PrecedenceTable
+	// SExpr prints the formula as S-expression, e.g. (and A (not B)), using the symbols
+	// of the style as operator names. Minimal and Precedence are ignored.
+	SExpr bool
+}
+
+var asciiOps = map[OpType]string{AndOp: "&", OrOp: "|", IfOp: "->", IffOp: "<->",
+	XorOp: "^", NandOp: "!&", NorOp: "!|", ImpliedByOp: "<-"}
+
+var (
+	// ASCIIStyle prints formulas exactly like String(), e.g. ((!A & B) -> C).
+	ASCIIStyle = Style{
+		Not:    "!",
+		Ops:    asciiOps,
+		Top:    "true",
+		Bottom: "false",
+	}
+
+	// MinimalStyle works like ASCIIStyle but omits redundant parentheses, e.g. !A & B -> C.
+	MinimalStyle = Style{
+		Not:     "!",
+		Ops:     asciiOps,
+		Top:     "true",
+		Bottom:  "false",
+		Minimal: true,
+	}
+
+	// UnicodeStyle prints formulas with logic symbols, e.g. ((¬A ∧ B) → C).
+	UnicodeStyle = Style{
+		Not: "¬",
+		Ops: map[OpType]string{AndOp: "∧", OrOp: "∨", IfOp: "→", IffOp: "↔",
+			XorOp: "⊕", NandOp: "↑", NorOp: "↓", ImpliedByOp: "←"},
+		Top:    "⊤",
+		Bottom: "⊥",
+	}
+
+	// LaTeXStyle prints formulas as LaTeX math, e.g. ((\neg A \land B) \rightarrow C).
+	LaTeXStyle = Style{
+		Not: `\neg `,
+		Ops: map[OpType]string{AndOp: `\land`, OrOp: `\lor`, IfOp: `\rightarrow`, IffOp: `\leftrightarrow`,
+			XorOp: `\oplus`, NandOp: `\uparrow`, NorOp: `\downarrow`, ImpliedByOp: `\leftarrow`},
+		Top:    `\top`,
+		Bottom: `\bot`,
+	}
+
+	// SExprStyle prints formulas as S-expressions, e.g. (implies (and (not A) B) C).
+	SExprStyle = Style{
+		Not:    "not",
+		Ops:    opTypeNames,
+		Top:    "true",
+		Bottom: "false",
+		SExpr:  true,
+	}
+)
+
+// Format returns a string representation of the formula f in the given style.
+func Format(f LogicNode, style Style) string {
+	if style.Precedence == nil {
+		style.Precedence = DefaultPrecedence
+	}
+	p := printer{style: style}
+	if style.SExpr {
+		p.sexpr(f)
+	} else {
+		p.infix(f, false)
+	}
+	return p.sb.String()
+}
+
+type printer struct {
+	style Style
+	sb    strings.Builder
+}
+
+func (p *printer) symbol(op OpType) string {
+	symbol, ok := p.style.Ops[op]
+	if !ok {
+		panic(fmt.Sprintf("Unknown OpType=%d", op))
+	}
+	return symbol
+}
+
+func (p *printer) precedence(op OpType) Precedence {
+	prec, ok := p.style.Precedence[op]
+	if !ok {
+		panic(fmt.Sprintf("Unknown OpType=%d", op))
+	}
+	return prec
+}
+
+// power returns the binding power of the root of f. Variables, constants and
+// negations bind stronger than any binary operator.
+func (p *printer) power(f LogicNode) int {
+	switch f1 := f.(type) {
+	case *BinaryOp:
+		return p.precedence(f1.Op).Power
+	case *NaryOp:
+		if len(f1.Clauses) == 1 {
+			return p.power(f1.Clauses[0])
+		}
+		if len(f1.Clauses) > 1 {
+			return p.precedence(f1.Op).Power
+		}
+	}
+	return math.MaxInt
+}
+
+// infix prints f in infix notation. If the style is minimal, binary and n-ary operators
+// are only parenthesized if parens is set, otherwise they are always parenthesized.
+func (p *printer) infix(f LogicNode, parens bool) {
+	parens = parens || !p.style.Minimal
+	switch f1 := f.(type) {
+	case *NotOp:
+		p.sb.WriteString(p.style.Not)
+		p.infix(f1.X, p.power(f1.X) < math.MaxInt)
+	case *BinaryOp:
+		prec := p.precedence(f1.Op)
+		left, right := p.power(f1.X), p.power(f1.Y)
+		p.open(parens)
+		p.infix(f1.X, left < prec.Power || (left == prec.Power && prec.Assoc != LeftAssoc))
+		p.sb.WriteString(" " + p.symbol(f1.Op) + " ")
+		p.infix(f1.Y, right < prec.Power || (right == prec.Power && prec.Assoc != RightAssoc))
+		p.close(parens)
+	case *NaryOp:
+		if len(f1.Clauses) == 0 {
+			p.infix(emptyNaryValue(f1.Op), parens)
+			return
+		}
+		if len(f1.Clauses) == 1 && p.style.Minimal {
+			p.infix(f1.Clauses[0], parens)
+			return
+		}
+		prec := p.precedence(f1.Op)
+		p.open(parens)
+		for i, clause := range f1.Clauses {
+			if i > 0 {
+				p.sb.WriteString(" " + p.symbol(f1.Op) + " ")
+			}
+			p.infix(clause, p.power(clause) <= prec.Power)
+		}
+		p.close(parens)
+	case *Variable:
+		p.sb.WriteString(f1.Name)
+	case Leaf:
+		p.leaf(f1)
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+func (p *printer) open(parens bool) {
+	if parens {
+		p.sb.WriteString("(")
+	}
+}
+
+func (p *printer) close(parens bool) {
+	if parens {
+		p.sb.WriteString(")")
+	}
+}
+
+func (p *printer) leaf(l Leaf) {
+	if l {
+		p.sb.WriteString(p.style.Top)
+	} else {
+		p.sb.WriteString(p.style.Bottom)
+	}
+}
+
+// sexpr prints f as S-expression.
+func (p *printer) sexpr(f LogicNode) {
+	switch f1 := f.(type) {
+	case *NotOp:
+		p.sb.WriteString("(" + p.style.Not + " ")
+		p.sexpr(f1.X)
+		p.sb.WriteString(")")
+	case *BinaryOp:
+		p.sb.WriteString("(" + p.symbol(f1.Op) + " ")
+		p.sexpr(f1.X)
+		p.sb.WriteString(" ")
+		p.sexpr(f1.Y)
+		p.sb.WriteString(")")
+	case *NaryOp:
+		if len(f1.Clauses) == 0 {
+			p.sexpr(emptyNaryValue(f1.Op))
+			return
+		}
+		p.sb.WriteString("(" + p.symbol(f1.Op))
+		for _, clause := range f1.Clauses {
+			p.sb.WriteString(" ")
+			p.sexpr(clause)
+		}
+		p.sb.WriteString(")")
+	case *Variable:
+		p.sb.WriteString(f1.Name)
+	case Leaf:
+		p.leaf(f1)
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+// emptyNaryValue returns the value of a NaryOp without clauses, i.e., the neutral
+// element of its operator.
+func emptyNaryValue(op OpType) Leaf {
+	switch op {
+	case AndOp:
+		return Leaf(true)
+	case OrOp, XorOp:
+		return Leaf(false)
+	default:
+		panic(fmt.Sprintf("Unknown OpType=%d\n", op))
+	}
+}
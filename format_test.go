@@ -0,0 +1,84 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	f := Implies(And(Not(Var("A")), Var("B")), Iff(Var("C"), Top()))
+
+	t.Run("ASCIIStyle equals String()", func(t *testing.T) {
+		formulas := []LogicNode{
+			f,
+			NewDisjunction(Var("A"), Not(Not(Var("B"))), NewConjunction(Var("C"), Bottom())),
+			NewConjunction(Or(Var("A"), Var("B"))),
+			NewConjunction(),
+			NewDisjunction(),
+			Not(Implies(Var("A"), Var("B"))),
+		}
+		for _, g := range formulas {
+			assert.Equal(t, g.String(), Format(g, ASCIIStyle))
+		}
+	})
+	t.Run("UnicodeStyle", func(t *testing.T) {
+		assert.Equal(t, "((¬A ∧ B) → (C ↔ ⊤))", Format(f, UnicodeStyle))
+	})
+	t.Run("LaTeXStyle", func(t *testing.T) {
+		assert.Equal(t, `((\neg A \land B) \rightarrow (C \leftrightarrow \top))`, Format(f, LaTeXStyle))
+	})
+	t.Run("SExprStyle", func(t *testing.T) {
+		assert.Equal(t, "(implies (and (not A) B) (iff C true))", Format(f, SExprStyle))
+		assert.Equal(t, "(or A B C)", Format(NewDisjunction(Var("A"), Var("B"), Var("C")), SExprStyle))
+		assert.Equal(t, "false", Format(NewDisjunction(), SExprStyle))
+	})
+	t.Run("MinimalStyle omits redundant parentheses", func(t *testing.T) {
+		assert.Equal(t, "!A & B -> (C <-> true)", Format(f, MinimalStyle))
+		assert.Equal(t, "A & B | C", Format(Or(And(Var("A"), Var("B")), Var("C")), MinimalStyle))
+		assert.Equal(t, "A & (B | C)", Format(And(Var("A"), Or(Var("B"), Var("C"))), MinimalStyle))
+		assert.Equal(t, "!(A | B)", Format(Not(Or(Var("A"), Var("B"))), MinimalStyle))
+		assert.Equal(t, "!!A", Format(Not(Not(Var("A"))), MinimalStyle))
+		assert.Equal(t, "A | (B | C) | D", Format(NewDisjunction(Var("A"), Or(Var("B"), Var("C")), Var("D")), MinimalStyle))
+		assert.Equal(t, "A", Format(NewConjunction(Var("A")), MinimalStyle))
+	})
+	t.Run("MinimalStyle respects associativity", func(t *testing.T) {
+		assert.Equal(t, "A -> B -> C", Format(Implies(Var("A"), Implies(Var("B"), Var("C"))), MinimalStyle))
+		assert.Equal(t, "(A -> B) -> C", Format(Implies(Implies(Var("A"), Var("B")), Var("C")), MinimalStyle))
+		assert.Equal(t, "A <-> B <-> C", Format(Iff(Iff(Var("A"), Var("B")), Var("C")), MinimalStyle))
+		assert.Equal(t, "A <-> (B <-> C)", Format(Iff(Var("A"), Iff(Var("B"), Var("C"))), MinimalStyle))
+	})
+	t.Run("minimal output parses to the same formula", func(t *testing.T) {
+		formulas := []LogicNode{
+			f,
+			Implies(Implies(Var("A"), Var("B")), Var("C")),
+			Iff(Var("A"), Iff(Not(Var("B")), Or(Var("C"), And(Var("D"), Var("E"))))),
+		}
+		for _, g := range formulas {
+			assert.Equal(t, g, MustParse(Format(g, MinimalStyle)))
+		}
+	})
+	t.Run("custom precedence table", func(t *testing.T) {
+		style := MinimalStyle
+		style.Precedence = PrecedenceTable{
+			AndOp: {Power: 1, Assoc: LeftAssoc},
+			OrOp:  {Power: 2, Assoc: LeftAssoc},
+			IfOp:  {Power: 3, Assoc: RightAssoc},
+			IffOp: {Power: 4, Assoc: LeftAssoc},
+		}
+		assert.Equal(t, "A & B | C", Format(And(Var("A"), Or(Var("B"), Var("C"))), style))
+		assert.Equal(t, "(A & B) | C", Format(Or(And(Var("A"), Var("B")), Var("C")), style))
+	})
+	t.Run("additional connectives", func(t *testing.T) {
+		g := Xor(Nand(Var("A"), Var("B")), ImpliedBy(Nor(Var("C"), Var("D")), Var("E")))
+		assert.Equal(t, g.String(), Format(g, ASCIIStyle))
+		assert.Equal(t, "((A ↑ B) ⊕ ((C ↓ D) ← E))", Format(g, UnicodeStyle))
+		assert.Equal(t, `((A \uparrow B) \oplus ((C \downarrow D) \leftarrow E))`, Format(g, LaTeXStyle))
+		assert.Equal(t, "(xor (nand A B) (impliedby (nor C D) E))", Format(g, SExprStyle))
+		assert.Equal(t, "A !& B ^ (C !| D <- E)", Format(g, MinimalStyle))
+		assert.Equal(t, g, MustParse(Format(g, MinimalStyle)))
+	})
+	t.Run("unknown operator panics", func(t *testing.T) {
+		assert.Panics(t, func() { Format(&BinaryOp{X: Var("A"), Y: Var("B"), Op: OpType(42)}, UnicodeStyle) })
+	})
+}
@@ -1,5 +1,11 @@
 package logo
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // LogicNode represents a node in a propositional logic formula.
 type LogicNode interface {
 	// Eval evaluates the formula represented by the LogicNode given an assignment of truth values to variables.
@@ -12,3 +18,35 @@ type LogicNode interface {
 
 // Assignment represents an assignment of truth values to variables.
 type Assignment map[string]bool
+
+// ErrUnboundVariable is returned if a variable of a formula has no value.
+var ErrUnboundVariable = errors.New("unbound variable")
+
+// ErrTooManyVariables is returned if a formula has more variables than supported.
+var ErrTooManyVariables = errors.New("too many variables")
+
+// EvalChecked evaluates the formula f like f.Eval(assignment), but instead of panicking,
+// it returns an error that wraps ErrUnboundVariable if a variable of f is missing in the
+// assignment.
+func EvalChecked(f LogicNode, assignment Assignment) (bool, error) {
+	for _, name := range Vars(f) {
+		if _, ok := assignment[name]; !ok {
+			return false, fmt.Errorf("%w: %s is missing in assignment=%v", ErrUnboundVariable, name, assignment)
+		}
+	}
+	return f.Eval(assignment), nil
+}
+
+// String returns the assignment in natural sort order of the variables (see Vars),
+// e.g., A=true, B=false.
+func (a Assignment) String() string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	SortNatural(names)
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s=%t", name, a[name])
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,30 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentString(t *testing.T) {
+	t.Run("natural sort order", func(t *testing.T) {
+		a := Assignment{"x10": true, "x2": false, "A": true}
+		assert.Equal(t, "A=true, x2=false, x10=true", a.String())
+	})
+	t.Run("empty assignment", func(t *testing.T) {
+		assert.Equal(t, "", Assignment{}.String())
+	})
+}
+
+func TestEvalChecked(t *testing.T) {
+	t.Run("complete assignment", func(t *testing.T) {
+		value, err := EvalChecked(MustParse("A -> B"), Assignment{"A": true, "B": false})
+		assert.NoError(t, err)
+		assert.False(t, value)
+	})
+	t.Run("unbound variable", func(t *testing.T) {
+		_, err := EvalChecked(MustParse("A -> (B | C)"), Assignment{"A": true, "C": false})
+		assert.ErrorIs(t, err, ErrUnboundVariable)
+		assert.Contains(t, err.Error(), "B")
+	})
+}
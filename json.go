@@ -0,0 +1,261 @@
+package logo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Formulas are encoded as JSON objects tagged by the field "op":
+//
+//	{"op": "var", "name": "A"}                              Variable
+//	{"op": "const", "value": true}                          Leaf
+//	{"op": "not", "args": [X]}                              NotOp
+//	{"op": "and", "args": [X, Y]}                           BinaryOp (and, or, implies, iff, xor, nand, nor, impliedby)
+//	{"op": "or", "nary": true, "args": [X1, X2, ..., Xn]}   NaryOp (and, or, xor)
+
+const (
+	jsonVar   = "var"
+	jsonConst = "const"
+	jsonNot   = "not"
+)
+
+var opTypeNames = map[OpType]string{
+	AndOp:       "and",
+	OrOp:        "or",
+	IfOp:        "implies",
+	IffOp:       "iff",
+	XorOp:       "xor",
+	NandOp:      "nand",
+	NorOp:       "nor",
+	ImpliedByOp: "impliedby",
+}
+
+// jsonNode is the union of all encoded node types.
+type jsonNode struct {
+	Op    string            `json:"op"`
+	Name  string            `json:"name,omitempty"`
+	Value *bool             `json:"value,omitempty"`
+	Nary  bool              `json:"nary,omitempty"`
+	Args  []json.RawMessage `json:"args,omitempty"`
+}
+
+type jsonOperator struct {
+	Op   string      `json:"op"`
+	Nary bool        `json:"nary,omitempty"`
+	Args []LogicNode `json:"args"`
+}
+
+// DecodeJSON decodes a formula encoded by json.Marshal.
+func DecodeJSON(data []byte) (LogicNode, error) {
+	var node jsonNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return node.decode()
+}
+
+func (n *jsonNode) decode() (LogicNode, error) {
+	var err error
+	switch {
+	case n.Op == jsonVar:
+		var v Variable
+		if err = v.fromJSON(n); err == nil {
+			return &v, nil
+		}
+	case n.Op == jsonConst:
+		var l Leaf
+		if err = l.fromJSON(n); err == nil {
+			return l, nil
+		}
+	case n.Op == jsonNot:
+		var not NotOp
+		if err = not.fromJSON(n); err == nil {
+			return &not, nil
+		}
+	case n.Nary:
+		var nary NaryOp
+		if err = nary.fromJSON(n); err == nil {
+			return &nary, nil
+		}
+	default:
+		var b BinaryOp
+		if err = b.fromJSON(n); err == nil {
+			return &b, nil
+		}
+	}
+	return nil, err
+}
+
+func (n *jsonNode) decodeArgs(num int) ([]LogicNode, error) {
+	if num >= 0 && len(n.Args) != num {
+		return nil, fmt.Errorf("op %q expects %d args, got %d", n.Op, num, len(n.Args))
+	}
+	if len(n.Args) == 0 {
+		return nil, nil
+	}
+	args := make([]LogicNode, len(n.Args))
+	for i, raw := range n.Args {
+		arg, err := DecodeJSON(raw)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+	return args, nil
+}
+
+func parseOpTypeName(name string) (OpType, error) {
+	for op, opName := range opTypeNames {
+		if opName == name {
+			return op, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown op %q", name)
+}
+
+func opTypeName(op OpType) string {
+	name, ok := opTypeNames[op]
+	if !ok {
+		panic(fmt.Sprintf("Unknown OpType=%d", op))
+	}
+	return name
+}
+
+func unmarshalNode(data []byte) (*jsonNode, error) {
+	var node jsonNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+func (v Variable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonNode{Op: jsonVar, Name: v.Name})
+}
+
+func (v *Variable) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalNode(data)
+	if err != nil {
+		return err
+	}
+	return v.fromJSON(node)
+}
+
+func (v *Variable) fromJSON(n *jsonNode) error {
+	if n.Op != jsonVar {
+		return fmt.Errorf("cannot decode op %q into Variable", n.Op)
+	}
+	if n.Name == "" {
+		return fmt.Errorf("op %q requires a name", n.Op)
+	}
+	v.Name = n.Name
+	return nil
+}
+
+func (l Leaf) MarshalJSON() ([]byte, error) {
+	value := bool(l)
+	return json.Marshal(jsonNode{Op: jsonConst, Value: &value})
+}
+
+func (l *Leaf) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalNode(data)
+	if err != nil {
+		return err
+	}
+	return l.fromJSON(node)
+}
+
+func (l *Leaf) fromJSON(n *jsonNode) error {
+	if n.Op != jsonConst {
+		return fmt.Errorf("cannot decode op %q into Leaf", n.Op)
+	}
+	if n.Value == nil {
+		return fmt.Errorf("op %q requires a value", n.Op)
+	}
+	*l = Leaf(*n.Value)
+	return nil
+}
+
+func (n NotOp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonOperator{Op: jsonNot, Args: []LogicNode{n.X}})
+}
+
+func (n *NotOp) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalNode(data)
+	if err != nil {
+		return err
+	}
+	return n.fromJSON(node)
+}
+
+func (n *NotOp) fromJSON(node *jsonNode) error {
+	if node.Op != jsonNot {
+		return fmt.Errorf("cannot decode op %q into NotOp", node.Op)
+	}
+	args, err := node.decodeArgs(1)
+	if err != nil {
+		return err
+	}
+	n.X = args[0]
+	return nil
+}
+
+func (b BinaryOp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonOperator{Op: opTypeName(b.Op), Args: []LogicNode{b.X, b.Y}})
+}
+
+func (b *BinaryOp) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalNode(data)
+	if err != nil {
+		return err
+	}
+	return b.fromJSON(node)
+}
+
+func (b *BinaryOp) fromJSON(node *jsonNode) error {
+	op, err := parseOpTypeName(node.Op)
+	if err != nil {
+		return err
+	}
+	if node.Nary {
+		return fmt.Errorf("cannot decode n-ary op %q into BinaryOp", node.Op)
+	}
+	args, err := node.decodeArgs(2)
+	if err != nil {
+		return err
+	}
+	b.X, b.Y, b.Op = args[0], args[1], op
+	return nil
+}
+
+func (n NaryOp) MarshalJSON() ([]byte, error) {
+	clauses := n.Clauses
+	if clauses == nil {
+		clauses = []LogicNode{}
+	}
+	return json.Marshal(jsonOperator{Op: opTypeName(n.Op), Nary: true, Args: clauses})
+}
+
+func (n *NaryOp) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalNode(data)
+	if err != nil {
+		return err
+	}
+	return n.fromJSON(node)
+}
+
+func (n *NaryOp) fromJSON(node *jsonNode) error {
+	op, err := parseOpTypeName(node.Op)
+	if err != nil {
+		return err
+	}
+	if op != AndOp && op != OrOp && op != XorOp {
+		return fmt.Errorf("op %q cannot be n-ary", node.Op)
+	}
+	args, err := node.decodeArgs(-1)
+	if err != nil {
+		return err
+	}
+	n.Clauses, n.Op = args, op
+	return nil
+}
@@ -0,0 +1,79 @@
+package logo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("encode tagged nodes", func(t *testing.T) {
+		data, err := json.Marshal(Implies(Not(Var("A")), NewDisjunction(Top(), Var("B"))))
+		assert.NoError(t, err)
+		expected := `{"op":"implies","args":[{"op":"not","args":[{"op":"var","name":"A"}]},` +
+			`{"op":"or","nary":true,"args":[{"op":"const","value":true},{"op":"var","name":"B"}]}]}`
+		assert.JSONEq(t, expected, string(data))
+	})
+	t.Run("round trip", func(t *testing.T) {
+		formulas := []LogicNode{
+			Var("A"),
+			Top(),
+			Bottom(),
+			Not(Not(Var("A"))),
+			Iff(And(Var("A"), Var("B")), Or(Var("C"), Implies(Var("D"), Bottom()))),
+			NewConjunction(Var("A"), NewDisjunction(Var("B"), Var("C")), Not(Var("D"))),
+			NewConjunction(Var("A"), Var("B")),
+			NewDisjunction(),
+			Xor(Nand(Var("A"), Var("B")), ImpliedBy(Nor(Var("C"), Var("D")), Var("E"))),
+			NewExclusiveDisjunction(Var("A"), Var("B"), Var("C")),
+		}
+		for _, f := range formulas {
+			data, err := json.Marshal(f)
+			assert.NoError(t, err)
+			decoded, err := DecodeJSON(data)
+			assert.NoError(t, err)
+			assert.Equal(t, f, decoded)
+		}
+	})
+	t.Run("unmarshal into concrete types", func(t *testing.T) {
+		var b BinaryOp
+		assert.NoError(t, json.Unmarshal([]byte(`{"op":"and","args":[{"op":"var","name":"A"},{"op":"const","value":false}]}`), &b))
+		assert.Equal(t, And(Var("A"), Bottom()), &b)
+
+		var l Leaf
+		assert.NoError(t, json.Unmarshal([]byte(`{"op":"const","value":true}`), &l))
+		assert.Equal(t, Top(), l)
+
+		var v Variable
+		assert.Error(t, json.Unmarshal([]byte(`{"op":"const","value":true}`), &v))
+	})
+	t.Run("structs containing formulas", func(t *testing.T) {
+		type exercise struct {
+			Question *BinaryOp `json:"question"`
+		}
+		in := exercise{Question: And(Var("A"), Var("B")).(*BinaryOp)}
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+		var out exercise
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, in, out)
+	})
+	t.Run("invalid input", func(t *testing.T) {
+		inputs := []string{
+			`{"op":"xnor","args":[]}`,
+			`{"op":"nand","nary":true,"args":[]}`,
+			`{"op":"and","args":[{"op":"var","name":"A"}]}`,
+			`{"op":"not","args":[]}`,
+			`{"op":"implies","nary":true,"args":[]}`,
+			`{"op":"var"}`,
+			`{"op":"const"}`,
+			`{"op":"not","args":[{"op":"var","name":1}]}`,
+			`[]`,
+		}
+		for _, input := range inputs {
+			_, err := DecodeJSON([]byte(input))
+			assert.Error(t, err, input)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package logo
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+// tokenKind is an enumeration of the lexical tokens of the infix formula syntax.
+const (
+	tokEOF       tokenKind = iota // end of input
+	tokIllegal                    // unrecognized character sequence
+	tokIdent                      // variable name
+	tokTrue                       // true
+	tokFalse                      // false
+	tokNot                        // !
+	tokAnd                        // &
+	tokOr                         // |
+	tokIf                         // ->
+	tokIff                        // <->
+	tokXor                        // ^
+	tokNand                       // !&
+	tokNor                        // !|
+	tokImpliedBy                  // <-
+	tokLParen                     // (
+	tokRParen                     // )
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int // byte offset of the first character of the token
+}
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokEOF:
+		return "end of input"
+	case tokIllegal:
+		return "illegal token"
+	case tokIdent:
+		return "variable"
+	case tokTrue:
+		return "true"
+	case tokFalse:
+		return "false"
+	case tokNot:
+		return "!"
+	case tokAnd:
+		return AndOp.String()
+	case tokOr:
+		return OrOp.String()
+	case tokIf:
+		return IfOp.String()
+	case tokIff:
+		return IffOp.String()
+	case tokXor:
+		return XorOp.String()
+	case tokNand:
+		return NandOp.String()
+	case tokNor:
+		return NorOp.String()
+	case tokImpliedBy:
+		return ImpliedByOp.String()
+	case tokLParen:
+		return "("
+	case tokRParen:
+		return ")"
+	default:
+		panic(fmt.Sprintf("Unknown tokenKind=%d", k))
+	}
+}
+
+// spelling maps a symbol or a keyword of an infix dialect to its token.
+type spelling struct {
+	text string
+	kind tokenKind
+}
+
+// lexer splits the input into tokens of an infix dialect.
+type lexer struct {
+	dialect *infixDialect
+	input   string
+	pos     int
+}
+
+func newLexer(dialect *infixDialect, input string) *lexer {
+	return &lexer{dialect: dialect, input: input}
+}
+
+// next returns the next token of the input. After the end of the input has been
+// reached, next keeps returning tokEOF.
+func (l *lexer) next() token {
+	l.skipWhitespace()
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{kind: tokEOF, pos: start}
+	}
+
+	if sym, ok := l.matchSymbol(); ok {
+		l.pos += len(sym.text)
+		return token{kind: sym.kind, text: sym.text, pos: start}
+	}
+
+	r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+	if isIdentStart(r) {
+		for l.pos < len(l.input) {
+			r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+			if !isIdentPart(r) {
+				break
+			}
+			l.pos += size
+		}
+		text := l.input[start:l.pos]
+		if kind, ok := l.dialect.keyword(text); ok {
+			return token{kind: kind, text: text, pos: start}
+		}
+		return token{kind: tokIdent, text: text, pos: start}
+	}
+
+	// consume a run of unknown symbols such as => or ~ as a single token
+	l.pos += size
+	for l.pos < len(l.input) && !l.atTokenBoundary() {
+		_, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		l.pos += size
+	}
+	return token{kind: tokIllegal, text: l.input[start:l.pos], pos: start}
+}
+
+// matchSymbol returns the longest symbol of the dialect at the current position.
+func (l *lexer) matchSymbol() (spelling, bool) {
+	// symbols are sorted by decreasing length
+	for _, sym := range l.dialect.symbols {
+		if strings.HasPrefix(l.input[l.pos:], sym.text) {
+			return sym, true
+		}
+	}
+	return spelling{}, false
+}
+
+// atTokenBoundary returns true iff the input at the current position continues
+// with whitespace or a valid token.
+func (l *lexer) atTokenBoundary() bool {
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos:])
+	if unicode.IsSpace(r) || isIdentPart(r) {
+		return true
+	}
+	_, ok := l.matchSymbol()
+	return ok
+}
+
+func (l *lexer) skipWhitespace() {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		l.pos += size
+	}
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r)
+}
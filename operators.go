@@ -9,10 +9,14 @@ type OpType int
 
 // OpType is an enumeration of the different types of binary logical operators.
 const (
-	AndOp OpType = iota // X AND Y
-	OrOp                // X OR Y
-	IfOp                // IF X THEN Y
-	IffOp               // X IFF Y
+	AndOp       OpType = iota // X AND Y
+	OrOp                      // X OR Y
+	IfOp                      // IF X THEN Y
+	IffOp                     // X IFF Y
+	XorOp                     // X XOR Y
+	NandOp                    // X NAND Y
+	NorOp                     // X NOR Y
+	ImpliedByOp               // X IF Y, i.e., IF Y THEN X
 )
 
 type NotOp struct {
@@ -24,6 +28,7 @@ type BinaryOp struct {
 	Op   OpType
 }
 
+// NaryOp joins any number of clauses by one of the associative operators AndOp, OrOp or XorOp.
 type NaryOp struct {
 	Clauses []LogicNode
 	Op      OpType
@@ -54,6 +59,26 @@ func Iff(x, y LogicNode) LogicNode {
 	return &BinaryOp{X: x, Y: y, Op: IffOp}
 }
 
+// Xor returns a LogicNode that represents the logical formula X XOR Y.
+func Xor(x, y LogicNode) LogicNode {
+	return &BinaryOp{X: x, Y: y, Op: XorOp}
+}
+
+// Nand returns a LogicNode that represents the logical formula X NAND Y, i.e., NOT (X AND Y).
+func Nand(x, y LogicNode) LogicNode {
+	return &BinaryOp{X: x, Y: y, Op: NandOp}
+}
+
+// Nor returns a LogicNode that represents the logical formula X NOR Y, i.e., NOT (X OR Y).
+func Nor(x, y LogicNode) LogicNode {
+	return &BinaryOp{X: x, Y: y, Op: NorOp}
+}
+
+// ImpliedBy returns a LogicNode that represents the converse implication X IF Y, i.e., IF Y THEN X.
+func ImpliedBy(x, y LogicNode) LogicNode {
+	return &BinaryOp{X: x, Y: y, Op: ImpliedByOp}
+}
+
 func NewConjunction(clauses ...LogicNode) *NaryOp {
 	return &NaryOp{Clauses: clauses, Op: AndOp}
 }
@@ -62,6 +87,11 @@ func NewDisjunction(disjuncts ...LogicNode) *NaryOp {
 	return &NaryOp{Clauses: disjuncts, Op: OrOp}
 }
 
+// NewExclusiveDisjunction returns a LogicNode that is true iff an odd number of clauses is true.
+func NewExclusiveDisjunction(clauses ...LogicNode) *NaryOp {
+	return &NaryOp{Clauses: clauses, Op: XorOp}
+}
+
 func (op OpType) String() string {
 	switch op {
 	case AndOp:
@@ -72,6 +102,14 @@ func (op OpType) String() string {
 		return "->"
 	case IffOp:
 		return "<->"
+	case XorOp:
+		return "^"
+	case NandOp:
+		return "!&"
+	case NorOp:
+		return "!|"
+	case ImpliedByOp:
+		return "<-"
 	default:
 		panic(fmt.Sprintf("Unknown OpType=%d", op))
 	}
@@ -99,6 +137,14 @@ func (b BinaryOp) Eval(assignment Assignment) bool {
 		return !b.X.Eval(assignment) || b.Y.Eval(assignment)
 	case IffOp:
 		return (!b.X.Eval(assignment) && !b.Y.Eval(assignment)) || (b.X.Eval(assignment) && b.Y.Eval(assignment))
+	case XorOp:
+		return b.X.Eval(assignment) != b.Y.Eval(assignment)
+	case NandOp:
+		return !(b.X.Eval(assignment) && b.Y.Eval(assignment))
+	case NorOp:
+		return !(b.X.Eval(assignment) || b.Y.Eval(assignment))
+	case ImpliedByOp:
+		return b.X.Eval(assignment) || !b.Y.Eval(assignment)
 	default:
 		panic(fmt.Sprintf("Unknown OpType=%d", b.Op))
 	}
@@ -125,6 +171,14 @@ func (b BinaryOp) String() string {
 		return fmt.Sprintf("(%s -> %s)", b.X.String(), b.Y.String())
 	case IffOp:
 		return fmt.Sprintf("(%s <-> %s)", b.X.String(), b.Y.String())
+	case XorOp:
+		return fmt.Sprintf("(%s ^ %s)", b.X.String(), b.Y.String())
+	case NandOp:
+		return fmt.Sprintf("(%s !& %s)", b.X.String(), b.Y.String())
+	case NorOp:
+		return fmt.Sprintf("(%s !| %s)", b.X.String(), b.Y.String())
+	case ImpliedByOp:
+		return fmt.Sprintf("(%s <- %s)", b.X.String(), b.Y.String())
 	default:
 		panic(fmt.Sprintf("Unknown OpType=%d\n", b.Op))
 	}
@@ -146,6 +200,12 @@ func (n NaryOp) Eval(assignment Assignment) bool {
 			}
 		}
 		return false
+	case XorOp:
+		parity := false
+		for _, clause := range n.Clauses {
+			parity = parity != clause.Eval(assignment)
+		}
+		return parity
 	default:
 		panic(fmt.Sprintf("Unknown OpType=%d\n", n.Op))
 	}
@@ -167,7 +227,7 @@ func (n NaryOp) String() string {
 		switch n.Op {
 		case AndOp:
 			return "true"
-		case OrOp:
+		case OrOp, XorOp:
 			return "false"
 		default:
 			panic(fmt.Sprintf("Unknown OpType=%d\n", n.Op))
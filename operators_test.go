@@ -0,0 +1,49 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdditionalConnectives(t *testing.T) {
+	assignments := []Assignment{
+		{"A": false, "B": false},
+		{"A": false, "B": true},
+		{"A": true, "B": false},
+		{"A": true, "B": true},
+	}
+
+	t.Run("truth tables", func(t *testing.T) {
+		cases := []struct {
+			f        LogicNode
+			expected []bool
+		}{
+			{Xor(Var("A"), Var("B")), []bool{false, true, true, false}},
+			{Nand(Var("A"), Var("B")), []bool{true, true, true, false}},
+			{Nor(Var("A"), Var("B")), []bool{true, false, false, false}},
+			{ImpliedBy(Var("A"), Var("B")), []bool{true, false, true, true}},
+		}
+		for _, c := range cases {
+			for i, assignment := range assignments {
+				assert.Equal(t, c.expected[i], c.f.Eval(assignment), "%s for %v", c.f, assignment)
+			}
+		}
+	})
+	t.Run("String()", func(t *testing.T) {
+		assert.Equal(t, "(A ^ B)", Xor(Var("A"), Var("B")).String())
+		assert.Equal(t, "(A !& B)", Nand(Var("A"), Var("B")).String())
+		assert.Equal(t, "(A !| B)", Nor(Var("A"), Var("B")).String())
+		assert.Equal(t, "(A <- B)", ImpliedBy(Var("A"), Var("B")).String())
+		assert.Equal(t, "(A ^ B ^ C)", NewExclusiveDisjunction(Var("A"), Var("B"), Var("C")).String())
+		assert.Equal(t, "false", NewExclusiveDisjunction().String())
+	})
+	t.Run("n-ary XOR is true iff an odd number of clauses is true", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Var("A"), Var("B"), Var("C"))
+		assert.False(t, f.Eval(Assignment{"A": false, "B": false, "C": false}))
+		assert.True(t, f.Eval(Assignment{"A": true, "B": false, "C": false}))
+		assert.False(t, f.Eval(Assignment{"A": true, "B": true, "C": false}))
+		assert.True(t, f.Eval(Assignment{"A": true, "B": true, "C": true}))
+		assert.False(t, NewExclusiveDisjunction().Eval(Assignment{}))
+	})
+}
@@ -0,0 +1,268 @@
+package logo
+
+import "fmt"
+
+// Parse parses a propositional formula written in the syntax emitted by String().
+//
+// Besides fully parenthesized input, Parse accepts formulas without parentheses
+// using the precedence of DefaultPrecedence, e.g., ! > & > | > -> > <->. Implication
+// is right associative, all other binary operators are left associative. A chain of
+// two operands joined by &, | or ^ yields a BinaryOp, a longer chain such as
+// (A | B | C) yields a NaryOp.
+// The keywords true and false denote the leaves Top() and Bottom().
+//
+// If the input is not a valid formula, Parse returns the first *SyntaxError.
+// Use ParseDiagnostics to obtain all syntax errors.
+func Parse(s string) (LogicNode, error) {
+	f, errs := ParseDiagnostics(s)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return f, nil
+}
+
+// MustParse works like Parse but panics if the input cannot be parsed.
+func MustParse(s string) LogicNode {
+	f, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("Cannot parse formula=%q: %s", s, err))
+	}
+	return f
+}
+
+// binding returns the binding power of a binary operator token and whether the
+// operator is right associative according to DefaultPrecedence. Tokens that are
+// no binary operators have a binding power of 0.
+func binding(kind tokenKind) (OpType, int, bool) {
+	var op OpType
+	switch kind {
+	case tokIff:
+		op = IffOp
+	case tokIf:
+		op = IfOp
+	case tokOr:
+		op = OrOp
+	case tokAnd:
+		op = AndOp
+	case tokXor:
+		op = XorOp
+	case tokNand:
+		op = NandOp
+	case tokNor:
+		op = NorOp
+	case tokImpliedBy:
+		op = ImpliedByOp
+	default:
+		return 0, 0, false
+	}
+	prec := DefaultPrecedence[op]
+	return op, prec.Power, prec.Assoc == RightAssoc
+}
+
+// startsOperand returns true iff a token of the given kind may start a formula.
+func startsOperand(kind tokenKind) bool {
+	switch kind {
+	case tokNot, tokLParen, tokIdent, tokTrue, tokFalse:
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	dialect *infixDialect
+	src     string
+	lex     *lexer
+	tok     token // current lookahead token
+	depth   int   // number of currently open parentheses
+	errs    ErrorList
+}
+
+func newParser(d *infixDialect, s string) *parser {
+	p := &parser{dialect: d, src: s, lex: newLexer(d, s)}
+	p.advance()
+	return p
+}
+
+func (p *parser) advance() {
+	p.tok = p.lex.next()
+}
+
+// errorf records a syntax error spanning the input from start to end (byte offsets).
+func (p *parser) errorf(start, end int, expected []string, format string, args ...interface{}) {
+	p.errs = append(p.errs, &SyntaxError{
+		Pos:      sourcePosAt(p.src, start),
+		End:      sourcePosAt(p.src, end),
+		Msg:      fmt.Sprintf(format, args...),
+		Expected: expected,
+	})
+}
+
+// errorAt records a syntax error spanning the given token.
+func (p *parser) errorAt(tok token, expected []string, format string, args ...interface{}) {
+	p.errorf(tok.pos, tok.pos+len(tok.text), expected, format, args...)
+}
+
+// spellAll returns the spelling of the given tokens in the parser's dialect.
+func (p *parser) spellAll(kinds ...tokenKind) []string {
+	spellings := make([]string, len(kinds))
+	for i, kind := range kinds {
+		spellings[i] = p.dialect.spell(kind)
+	}
+	return spellings
+}
+
+// expectedOperand returns the tokens that may start an operand.
+func (p *parser) expectedOperand() []string {
+	return p.spellAll(tokIdent, tokTrue, tokFalse, tokNot, tokLParen)
+}
+
+var binaryOperatorTokens = []tokenKind{tokAnd, tokOr, tokIf, tokIff, tokXor, tokNand, tokNor, tokImpliedBy}
+
+// expectedOperators returns the tokens of the binary operators of the dialect.
+func (p *parser) expectedOperators() []string {
+	kinds := make([]tokenKind, 0, len(binaryOperatorTokens))
+	for _, kind := range binaryOperatorTokens {
+		if p.dialect.supports(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return p.spellAll(kinds...)
+}
+
+// expectedAfterOperand returns the tokens that may follow a complete operand.
+func (p *parser) expectedAfterOperand() []string {
+	if p.depth > 0 {
+		return append(p.expectedOperators(), p.dialect.spell(tokRParen))
+	}
+	return append(p.expectedOperators(), p.dialect.spell(tokEOF))
+}
+
+func (p *parser) describe(tok token) string {
+	switch tok.kind {
+	case tokEOF:
+		return tok.kind.String()
+	case tokIdent:
+		return fmt.Sprintf("variable %q", tok.text)
+	default:
+		return fmt.Sprintf("%q", tok.text)
+	}
+}
+
+// parseFormula parses a formula whose binary operators bind at least as strong as minPower.
+// It returns nil if no operand could be recovered.
+func (p *parser) parseFormula(minPower int) LogicNode {
+	lhs := p.parseUnary()
+	for {
+		switch {
+		case p.tok.kind == tokRParen && p.depth == 0:
+			p.errorAt(p.tok, p.expectedAfterOperand(), "unmatched %q", p.tok.text)
+			p.advance()
+			continue
+		case p.tok.kind == tokIllegal:
+			// an unknown operator: skip it and its right operand
+			p.errorAt(p.tok, p.expectedOperators(), "unknown operator %q", p.tok.text)
+			p.advance()
+			if startsOperand(p.tok.kind) {
+				p.parseUnary()
+			}
+			continue
+		case startsOperand(p.tok.kind):
+			// two juxtaposed operands: skip the second one
+			p.errorAt(p.tok, p.expectedAfterOperand(), "expected operator, found %s", p.describe(p.tok))
+			p.parseUnary()
+			continue
+		}
+
+		op, power, rightAssoc := binding(p.tok.kind)
+		if power == 0 || power < minPower {
+			return lhs
+		}
+		kind := p.tok.kind
+		p.advance()
+
+		if rightAssoc {
+			rhs := p.parseFormula(power)
+			lhs = chain(op, []LogicNode{lhs, rhs})
+			continue
+		}
+
+		// collect a chain of operands joined by the same operator
+		operands := []LogicNode{lhs, p.parseFormula(power + 1)}
+		for p.tok.kind == kind {
+			p.advance()
+			operands = append(operands, p.parseFormula(power+1))
+		}
+		lhs = chain(op, operands)
+	}
+}
+
+// chain joins the operands by op. Chains of conjunctions, disjunctions and
+// exclusive disjunctions with more than two operands become a NaryOp, all other
+// chains are folded to the left into nested BinaryOps. Missing (nil) operands are left out.
+func chain(op OpType, operands []LogicNode) LogicNode {
+	present := make([]LogicNode, 0, len(operands))
+	for _, operand := range operands {
+		if operand != nil {
+			present = append(present, operand)
+		}
+	}
+	if len(present) == 0 {
+		return nil
+	}
+	if len(present) > 2 && (op == AndOp || op == OrOp || op == XorOp) {
+		return &NaryOp{Clauses: present, Op: op}
+	}
+	f := present[0]
+	for _, operand := range present[1:] {
+		f = &BinaryOp{X: f, Y: operand, Op: op}
+	}
+	return f
+}
+
+// parseUnary parses a negation, a parenthesized formula, a variable or a constant.
+// It returns nil if no operand could be recovered.
+func (p *parser) parseUnary() LogicNode {
+	tok := p.tok
+	switch tok.kind {
+	case tokNot:
+		p.advance()
+		if !startsOperand(p.tok.kind) && p.tok.kind != tokIllegal {
+			p.errorAt(tok, p.expectedOperand(), "dangling %q: expected formula after negation, found %s", tok.text, p.describe(p.tok))
+			return nil
+		}
+		x := p.parseUnary()
+		if x == nil {
+			return nil
+		}
+		return Not(x)
+	case tokLParen:
+		p.advance()
+		p.depth++
+		f := p.parseFormula(0)
+		p.depth--
+		if p.tok.kind != tokRParen {
+			p.errorf(tok.pos, p.tok.pos, p.spellAll(tokRParen), "unbalanced parentheses: %q at %s is never closed", tok.text, sourcePosAt(p.src, tok.pos))
+			return f
+		}
+		p.advance()
+		return f
+	case tokIdent:
+		p.advance()
+		return Var(tok.text)
+	case tokTrue:
+		p.advance()
+		return Top()
+	case tokFalse:
+		p.advance()
+		return Bottom()
+	case tokIllegal:
+		// an unknown symbol in front of an operand: skip it
+		p.errorAt(tok, p.expectedOperand(), "unknown operator %q", tok.text)
+		p.advance()
+		return p.parseUnary()
+	default:
+		// a binary operator, a closing parenthesis or the end of the input: the operand is missing
+		p.errorAt(tok, p.expectedOperand(), "expected formula, found %s", p.describe(tok))
+		return nil
+	}
+}
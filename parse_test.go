@@ -0,0 +1,94 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("variables and constants", func(t *testing.T) {
+		assert.Equal(t, Var("A"), MustParse("A"))
+		assert.Equal(t, Var("x_10"), MustParse("x_10"))
+		assert.Equal(t, Top(), MustParse("true"))
+		assert.Equal(t, Bottom(), MustParse("false"))
+	})
+	t.Run("binary operators", func(t *testing.T) {
+		assert.Equal(t, And(Var("A"), Var("B")), MustParse("(A & B)"))
+		assert.Equal(t, Or(Var("A"), Var("B")), MustParse("(A | B)"))
+		assert.Equal(t, Implies(Var("A"), Var("B")), MustParse("(A -> B)"))
+		assert.Equal(t, Iff(Var("A"), Var("B")), MustParse("(A <-> B)"))
+	})
+	t.Run("additional connectives", func(t *testing.T) {
+		assert.Equal(t, Xor(Var("A"), Var("B")), MustParse("(A ^ B)"))
+		assert.Equal(t, Nand(Var("A"), Not(Var("B"))), MustParse("(A !& !B)"))
+		assert.Equal(t, Nor(Var("A"), Var("B")), MustParse("(A !| B)"))
+		assert.Equal(t, ImpliedBy(Var("A"), Var("B")), MustParse("(A <- B)"))
+		assert.Equal(t, NewExclusiveDisjunction(Var("A"), Var("B"), Var("C")), MustParse("A ^ B ^ C"))
+		assert.Equal(t, Nand(Nand(Var("A"), Var("B")), Var("C")), MustParse("A !& B !& C"))
+		assert.Equal(t, Or(Xor(And(Var("A"), Var("B")), Var("C")), Var("D")), MustParse("A & B ^ C | D"))
+		assert.Equal(t, Implies(Var("A"), ImpliedBy(Var("B"), Var("C"))), MustParse("A -> B <- C"))
+	})
+	t.Run("chains of three or more operands yield a NaryOp", func(t *testing.T) {
+		assert.Equal(t, NewConjunction(Var("A"), Var("B"), Var("C")), MustParse("(A & B & C)"))
+		assert.Equal(t, NewDisjunction(Var("A"), Var("B"), Var("C"), Var("D")), MustParse("A | B | C | D"))
+	})
+	t.Run("precedence without parentheses", func(t *testing.T) {
+		expected := Iff(Implies(Or(And(Not(Var("A")), Var("B")), Var("C")), Var("D")), Var("E"))
+		assert.Equal(t, expected, MustParse("!A & B | C -> D <-> E"))
+	})
+	t.Run("implication is right associative", func(t *testing.T) {
+		assert.Equal(t, Implies(Var("A"), Implies(Var("B"), Var("C"))), MustParse("A -> B -> C"))
+	})
+	t.Run("equivalence is left associative", func(t *testing.T) {
+		assert.Equal(t, Iff(Iff(Var("A"), Var("B")), Var("C")), MustParse("A <-> B <-> C"))
+	})
+	t.Run("String() round-trips", func(t *testing.T) {
+		formulas := []LogicNode{
+			Implies(And(Var("A"), Not(Var("B"))), Iff(Var("C"), Var("D"))),
+			NewDisjunction(Var("A"), Not(Not(Var("B"))), NewConjunction(Var("C"), Top(), Bottom())),
+			Not(Iff(Implies(Var("A"), Var("B")), Or(Var("A"), Var("B")))),
+			And(And(Var("A"), Var("B")), Var("C")),
+			Xor(Nand(Var("A"), Var("B")), Nor(ImpliedBy(Var("C"), Var("D")), NewExclusiveDisjunction(Var("A"), Var("B"), Var("C")))),
+		}
+		for _, f := range formulas {
+			parsed, err := Parse(f.String())
+			assert.NoError(t, err)
+			assert.Equal(t, f, parsed)
+		}
+	})
+	t.Run("empty NaryOp parses as a constant", func(t *testing.T) {
+		assert.Equal(t, Top(), MustParse(NewConjunction().String()))
+		assert.Equal(t, Bottom(), MustParse(NewDisjunction().String()))
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Run("empty input", func(t *testing.T) {
+		_, err := Parse("")
+		assert.Error(t, err)
+	})
+	t.Run("missing closing parenthesis", func(t *testing.T) {
+		_, err := Parse("(A & B")
+		assert.Error(t, err)
+		assert.Equal(t, 0, err.(*SyntaxError).Pos.Offset)
+		assert.Equal(t, 6, err.(*SyntaxError).End.Offset)
+	})
+	t.Run("unknown operator", func(t *testing.T) {
+		_, err := Parse("A # B")
+		assert.Error(t, err)
+		assert.Equal(t, 2, err.(*SyntaxError).Pos.Offset)
+	})
+	t.Run("dangling negation", func(t *testing.T) {
+		_, err := Parse("A & !")
+		assert.Error(t, err)
+	})
+	t.Run("trailing input", func(t *testing.T) {
+		_, err := Parse("A B")
+		assert.Error(t, err)
+		assert.Equal(t, 2, err.(*SyntaxError).Pos.Offset)
+	})
+	t.Run("MustParse panics on invalid input", func(t *testing.T) {
+		assert.Panics(t, func() { MustParse("A &") })
+	})
+}
@@ -0,0 +1,61 @@
+package logo
+
+// Position addresses a subformula by the path of child indices (see Children) on the way
+// from the root of the formula. The empty position addresses the root itself, e.g., the
+// position [1 0] addresses B in A & (B | C).
+type Position []int
+
+// Subformula is a subformula along with its position.
+type Subformula struct {
+	Position Position
+	Node     LogicNode
+}
+
+// Subformulas returns all subformulas of f in depth-first pre-order, starting with f itself.
+func Subformulas(f LogicNode) []Subformula {
+	subformulas := make([]Subformula, 0)
+	var collect func(node LogicNode, pos Position)
+	collect = func(node LogicNode, pos Position) {
+		subformulas = append(subformulas, Subformula{Position: pos, Node: node})
+		for i, child := range Children(node) {
+			childPos := make(Position, len(pos)+1)
+			copy(childPos, pos)
+			childPos[len(pos)] = i
+			collect(child, childPos)
+		}
+	}
+	collect(f, Position{})
+	return subformulas
+}
+
+// At returns the subformula of f at the given position, if the position exists.
+func At(f LogicNode, pos Position) (LogicNode, bool) {
+	for _, i := range pos {
+		children := Children(f)
+		if i < 0 || i >= len(children) {
+			return nil, false
+		}
+		f = children[i]
+	}
+	return f, true
+}
+
+// ReplaceAt returns a copy of f where the subformula at the given position is replaced by g,
+// if the position exists. f is not modified: the nodes on the path to the position are
+// copied, all other subformulas are shared with f.
+func ReplaceAt(f LogicNode, pos Position, g LogicNode) (LogicNode, bool) {
+	if len(pos) == 0 {
+		return g, true
+	}
+	children := Children(f)
+	i := pos[0]
+	if i < 0 || i >= len(children) {
+		return nil, false
+	}
+	child, ok := ReplaceAt(children[i], pos[1:], g)
+	if !ok {
+		return nil, false
+	}
+	children[i] = child
+	return WithChildren(f, children), true
+}
@@ -0,0 +1,73 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubformulas(t *testing.T) {
+	t.Run("pre-order with positions", func(t *testing.T) {
+		f := And(Not(Var("A")), NewDisjunction(Var("B"), Top(), Var("C")))
+		subformulas := Subformulas(f)
+		positions := make([]Position, len(subformulas))
+		nodes := make([]string, len(subformulas))
+		for i, s := range subformulas {
+			positions[i] = s.Position
+			nodes[i] = s.Node.String()
+		}
+		assert.Equal(t, []Position{{}, {0}, {0, 0}, {1}, {1, 0}, {1, 1}, {1, 2}}, positions)
+		assert.Equal(t, []string{"(!A & (B | true | C))", "!A", "A", "(B | true | C)", "B", "true", "C"}, nodes)
+	})
+	t.Run("positions address the subformulas", func(t *testing.T) {
+		f := MustParse("(A -> !B) <-> (C ^ (A | D))")
+		for _, s := range Subformulas(f) {
+			node, ok := At(f, s.Position)
+			assert.True(t, ok)
+			assert.Same(t, s.Node, node)
+		}
+	})
+}
+
+func TestAt(t *testing.T) {
+	f := And(Var("A"), Or(Var("B"), Var("C")))
+	t.Run("existing positions", func(t *testing.T) {
+		node, ok := At(f, Position{})
+		assert.True(t, ok)
+		assert.Same(t, f, node)
+
+		node, ok = At(f, Position{1, 0})
+		assert.True(t, ok)
+		assert.Equal(t, "B", node.String())
+	})
+	t.Run("invalid positions", func(t *testing.T) {
+		for _, pos := range []Position{{2}, {-1}, {0, 0}, {1, 1, 0}} {
+			_, ok := At(f, pos)
+			assert.False(t, ok, pos)
+		}
+	})
+}
+
+func TestReplaceAt(t *testing.T) {
+	t.Run("replaces the subformula", func(t *testing.T) {
+		f := And(Not(Var("A")), NewDisjunction(Var("B"), Var("C")))
+		g, ok := ReplaceAt(f, Position{1, 1}, Not(Var("D")))
+		assert.True(t, ok)
+		assert.Equal(t, "(!A & (B | !D))", g.String())
+		assert.Equal(t, "(!A & (B | C))", f.String())
+		// subformulas beside the position are shared
+		assert.Same(t, f.(*BinaryOp).X, g.(*BinaryOp).X)
+	})
+	t.Run("replaces the root", func(t *testing.T) {
+		g, ok := ReplaceAt(Var("A"), Position{}, Top())
+		assert.True(t, ok)
+		assert.Equal(t, Top(), g)
+	})
+	t.Run("invalid positions", func(t *testing.T) {
+		f := Not(Var("A"))
+		_, ok := ReplaceAt(f, Position{1}, Top())
+		assert.False(t, ok)
+		_, ok = ReplaceAt(f, Position{0, 0}, Top())
+		assert.False(t, ok)
+	})
+}
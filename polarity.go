@@ -0,0 +1,95 @@
+package logo
+
+import "fmt"
+
+// Sign is the polarity of a variable: it describes whether the variable occurs positively,
+// negatively or both in a formula. A formula is monotonically increasing in its positive
+// and decreasing in its negative variables.
+type Sign uint8
+
+const (
+	PolarityPositive Sign = 1 << iota // only under an even number of negations
+	PolarityNegative                  // only under an odd number of negations
+	PolarityMixed    = PolarityPositive | PolarityNegative
+)
+
+func (p Sign) String() string {
+	switch p {
+	case PolarityPositive:
+		return "positive"
+	case PolarityNegative:
+		return "negative"
+	case PolarityMixed:
+		return "mixed"
+	default:
+		panic(fmt.Sprintf("Unknown Sign=%d", p))
+	}
+}
+
+// flip swaps positive and negative polarity.
+func (p Sign) flip() Sign {
+	return (p&PolarityPositive)<<1 | (p&PolarityNegative)>>1
+}
+
+// Polarity returns the polarity of each variable of f. Negations, the antecedent of an
+// implication and the operands of NAND and NOR flip the polarity, the operands of IFF and
+// XOR have mixed polarity.
+func Polarity(f LogicNode) map[string]Sign {
+	polarities := make(map[string]Sign)
+	collectPolarities(f, PolarityPositive, polarities)
+	return polarities
+}
+
+func collectPolarities(f LogicNode, p Sign, polarities map[string]Sign) {
+	switch f1 := f.(type) {
+	case *Variable:
+		polarities[f1.Name] |= p
+	case Leaf:
+	case *NotOp:
+		collectPolarities(f1.X, p.flip(), polarities)
+	case *BinaryOp:
+		x, y := p, p
+		switch f1.Op {
+		case AndOp, OrOp:
+		case IfOp:
+			x = p.flip()
+		case ImpliedByOp:
+			y = p.flip()
+		case NandOp, NorOp:
+			x, y = p.flip(), p.flip()
+		case IffOp, XorOp:
+			x, y = PolarityMixed, PolarityMixed
+		default:
+			panic(fmt.Sprintf("Unknown OpType=%d", f1.Op))
+		}
+		collectPolarities(f1.X, x, polarities)
+		collectPolarities(f1.Y, y, polarities)
+	case *NaryOp:
+		switch f1.Op {
+		case AndOp, OrOp:
+		case XorOp:
+			p = PolarityMixed
+		default:
+			panic(fmt.Sprintf("Unknown OpType=%d", f1.Op))
+		}
+		for _, clause := range f1.Clauses {
+			collectPolarities(clause, p, polarities)
+		}
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+// PureLiterals returns the variables of f that do not have mixed polarity, each assigned
+// to the value that makes its literal true: true for positive and false for negative
+// variables. Since f is monotone in these variables, f is satisfiable iff the restriction
+// of f to the pure literals is satisfiable (see Restrict).
+func PureLiterals(f LogicNode) Assignment {
+	pure := make(Assignment)
+	for name, p := range Polarity(f) {
+		if p != PolarityMixed {
+			pure[name] = p == PolarityPositive
+		}
+	}
+	return pure
+}
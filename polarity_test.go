@@ -0,0 +1,63 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolarity(t *testing.T) {
+	t.Run("negation and implication flip the polarity", func(t *testing.T) {
+		f := MustParse("(A -> B) & !(C | !D)")
+		assert.Equal(t, map[string]Sign{"A": PolarityNegative, "B": PolarityPositive, "C": PolarityNegative, "D": PolarityPositive}, Polarity(f))
+	})
+	t.Run("equivalence makes both operands mixed", func(t *testing.T) {
+		f := MustParse("(A <-> B) | (C ^ !D) | E")
+		assert.Equal(t, map[string]Sign{"A": PolarityMixed, "B": PolarityMixed, "C": PolarityMixed, "D": PolarityMixed, "E": PolarityPositive}, Polarity(f))
+	})
+	t.Run("other operators", func(t *testing.T) {
+		f := NewConjunction(Nand(Var("A"), Var("B")), Nor(Not(Var("C")), Var("D")), ImpliedBy(Var("E"), Var("F")), NewExclusiveDisjunction(Var("G")))
+		assert.Equal(t, map[string]Sign{
+			"A": PolarityNegative, "B": PolarityNegative, "C": PolarityPositive, "D": PolarityNegative, "E": PolarityPositive, "F": PolarityNegative, "G": PolarityMixed,
+		}, Polarity(f))
+	})
+	t.Run("occurrences in both polarities", func(t *testing.T) {
+		f := MustParse("(A | B) & (!A | C) & !!C")
+		assert.Equal(t, map[string]Sign{"A": PolarityMixed, "B": PolarityPositive, "C": PolarityPositive}, Polarity(f))
+	})
+	t.Run("string representation", func(t *testing.T) {
+		assert.Equal(t, "positive", PolarityPositive.String())
+		assert.Equal(t, "negative", PolarityNegative.String())
+		assert.Equal(t, "mixed", PolarityMixed.String())
+	})
+}
+
+func TestPureLiterals(t *testing.T) {
+	t.Run("pure variables are assigned", func(t *testing.T) {
+		f := MustParse("(A | B) & (!A | !C) & (D <-> A)")
+		assert.Equal(t, Assignment{"B": true, "C": false}, PureLiterals(f))
+	})
+	t.Run("restriction to pure literals preserves satisfiability", func(t *testing.T) {
+		isSat := func(f LogicNode) bool {
+			p := Compile(f)
+			numBlocks, err := p.NumBlocks()
+			assert.NoError(t, err)
+			for block := uint64(0); block < numBlocks; block++ {
+				if result, _ := p.EvalBlock(block); result&p.BlockMask() != 0 {
+					return true
+				}
+			}
+			return false
+		}
+		formulas := []string{
+			"(A | B) & (!A | !C) & (D <-> A)",
+			"(A -> B) & A & !B & C",
+			"!(A & B) & (C | A) & !C",
+			"(A !| B) ^ (C -> A)",
+		}
+		for _, s := range formulas {
+			f := MustParse(s)
+			assert.Equal(t, isSat(f), isSat(Restrict(f, PureLiterals(f))), s)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package logo
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// polishDialect parses the prefix notation of Łukasiewicz: N denotes negation,
+// K conjunction, A disjunction, C implication, E equivalence, J exclusive disjunction,
+// D alternative denial (NAND), X joint denial (NOR) and B converse implication.
+// Variables are lowercase letters, optionally followed by digits (p, q, r1), and
+// 1 and 0 denote the constants Top() and Bottom(). Whitespace is ignored.
+type polishDialect struct{}
+
+func (polishDialect) Name() string {
+	return "polish"
+}
+
+func (polishDialect) ParseDiagnostics(s string) (LogicNode, ErrorList) {
+	p := &polishParser{src: s}
+	f := p.parseFormula()
+	p.skipWhitespace()
+	if p.pos < len(p.src) {
+		p.errorf(p.pos, len(p.src), nil, "unexpected %q after end of formula", p.src[p.pos:])
+	}
+	return f, p.errs
+}
+
+// polishConnectives are the symbols of the binary connectives and the negation.
+const polishConnectives = "NKACEJDXB"
+
+type polishParser struct {
+	src  string
+	pos  int
+	errs ErrorList
+}
+
+func (p *polishParser) errorf(start, end int, expected []string, format string, args ...interface{}) {
+	p.errs = append(p.errs, &SyntaxError{
+		Pos:      sourcePosAt(p.src, start),
+		End:      sourcePosAt(p.src, end),
+		Msg:      fmt.Sprintf(format, args...),
+		Expected: expected,
+	})
+}
+
+func (p *polishParser) skipWhitespace() {
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+// parseFormula parses a formula in prefix notation. It returns nil if no formula could be recovered.
+func (p *polishParser) parseFormula() LogicNode {
+	p.skipWhitespace()
+	if p.pos >= len(p.src) {
+		p.errorf(p.pos, p.pos, []string{"variable", "N", "K", "A", "C", "E", "J", "D", "X", "B", "1", "0"}, "expected formula, found end of input")
+		return nil
+	}
+
+	start := p.pos
+	r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += size
+	switch r {
+	case 'N':
+		x := p.parseFormula()
+		if x == nil {
+			return nil
+		}
+		return Not(x)
+	case 'K':
+		return p.parseOperands(AndOp)
+	case 'A':
+		return p.parseOperands(OrOp)
+	case 'C':
+		return p.parseOperands(IfOp)
+	case 'E':
+		return p.parseOperands(IffOp)
+	case 'J':
+		return p.parseOperands(XorOp)
+	case 'D':
+		return p.parseOperands(NandOp)
+	case 'X':
+		return p.parseOperands(NorOp)
+	case 'B':
+		return p.parseOperands(ImpliedByOp)
+	case '1':
+		return Top()
+	case '0':
+		return Bottom()
+	}
+
+	if isPolishVarStart(r) {
+		for p.pos < len(p.src) {
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			if !unicode.IsDigit(r) {
+				break
+			}
+			p.pos += size
+		}
+		return Var(p.src[start:p.pos])
+	}
+
+	// skip the unknown symbol
+	p.errorf(start, p.pos, nil, "unknown symbol %q", r)
+	return p.parseFormula()
+}
+
+func (p *polishParser) parseOperands(op OpType) LogicNode {
+	x := p.parseFormula()
+	y := p.parseFormula()
+	return chain(op, []LogicNode{x, y})
+}
+
+func isPolishVarStart(r rune) bool {
+	return unicode.IsLower(r)
+}
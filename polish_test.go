@@ -0,0 +1,41 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolish(t *testing.T) {
+	t.Run("connectives", func(t *testing.T) {
+		f, err := ParseDialect("polish", "CKpqNr")
+		assert.NoError(t, err)
+		assert.Equal(t, Implies(And(Var("p"), Var("q")), Not(Var("r"))), f)
+
+		f, err = ParseDialect("polish", "E A p1 p2 K 1 0")
+		assert.NoError(t, err)
+		assert.Equal(t, Iff(Or(Var("p1"), Var("p2")), And(Top(), Bottom())), f)
+	})
+	t.Run("additional connectives", func(t *testing.T) {
+		f, err := ParseDialect("polish", "JDpqBXpqr")
+		assert.NoError(t, err)
+		assert.Equal(t, Xor(Nand(Var("p"), Var("q")), ImpliedBy(Nor(Var("p"), Var("q")), Var("r"))), f)
+	})
+	t.Run("missing operand", func(t *testing.T) {
+		f, errs := Polish.ParseDiagnostics("Kp")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 2, errs[0].Pos.Offset)
+		assert.Equal(t, Var("p"), f)
+	})
+	t.Run("unknown symbol", func(t *testing.T) {
+		f, errs := Polish.ParseDiagnostics("KpZq")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 2, errs[0].Pos.Offset)
+		assert.Equal(t, And(Var("p"), Var("q")), f)
+	})
+	t.Run("trailing input", func(t *testing.T) {
+		_, errs := Polish.ParseDiagnostics("pq")
+		assert.Len(t, errs, 1)
+		assert.Equal(t, 1, errs[0].Pos.Offset)
+	})
+}
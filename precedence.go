@@ -0,0 +1,33 @@
+package logo
+
+type Associativity int
+
+// Associativity is an enumeration of the ways a chain of binary operators of the same
+// precedence is grouped in the absence of parentheses.
+const (
+	LeftAssoc  Associativity = iota // A op B op C = (A op B) op C
+	RightAssoc                      // A op B op C = A op (B op C)
+)
+
+// Precedence describes how strongly a binary operator binds. Operators with a
+// higher Power bind stronger than operators with a lower Power.
+type Precedence struct {
+	Power int
+	Assoc Associativity
+}
+
+// PrecedenceTable assigns a Precedence to each binary operator. Powers must be positive.
+type PrecedenceTable map[OpType]Precedence
+
+// DefaultPrecedence is the conventional precedence & > ^ > | > <- > -> > <-> used by the parser.
+// NAND binds like AND, NOR binds like OR. Negation always binds stronger than any binary operator.
+var DefaultPrecedence = PrecedenceTable{
+	IffOp:       {Power: 1, Assoc: LeftAssoc},
+	IfOp:        {Power: 2, Assoc: RightAssoc},
+	ImpliedByOp: {Power: 3, Assoc: LeftAssoc},
+	OrOp:        {Power: 4, Assoc: LeftAssoc},
+	NorOp:       {Power: 4, Assoc: LeftAssoc},
+	XorOp:       {Power: 5, Assoc: LeftAssoc},
+	AndOp:       {Power: 6, Assoc: LeftAssoc},
+	NandOp:      {Power: 6, Assoc: LeftAssoc},
+}
@@ -0,0 +1,31 @@
+package logo
+
+import "fmt"
+
+// Rename renames the variables of f according to the given mapping; variables that are
+// not mapped keep their name. All variables are renamed simultaneously, so the mapping may
+// swap names. f is not modified.
+func Rename(f LogicNode, names map[string]string) LogicNode {
+	substitution := make(map[string]LogicNode, len(names))
+	for from, to := range names {
+		substitution[from] = Var(to)
+	}
+	return Substitute(f, substitution)
+}
+
+// Normalize renames the variables of f to x1, x2, ... in the order of their first
+// occurrence (see VarsByOccurrence). Two formulas that only differ in the naming of their
+// variables have the same normalization.
+func Normalize(f LogicNode) LogicNode {
+	names := make(map[string]string)
+	for i, name := range VarsByOccurrence(f) {
+		names[name] = fmt.Sprintf("x%d", i+1)
+	}
+	return Rename(f, names)
+}
+
+// EqualUpToRenaming returns true iff there is a one-to-one renaming of the variables of f
+// such that f and g are structurally equal (see Equal).
+func EqualUpToRenaming(f, g LogicNode) bool {
+	return Equal(Normalize(f), Normalize(g))
+}
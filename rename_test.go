@@ -0,0 +1,46 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRename(t *testing.T) {
+	t.Run("domain names", func(t *testing.T) {
+		f := MustParse("A -> (B | !A)")
+		g := Rename(f, map[string]string{"A": "rain", "B": "wet"})
+		assert.Equal(t, "(rain -> (wet | !rain))", g.String())
+		assert.Equal(t, "(A -> (B | !A))", f.String())
+	})
+	t.Run("swap and partial mapping", func(t *testing.T) {
+		f := MustParse("A & B & C")
+		assert.Equal(t, "(B & A & C)", Rename(f, map[string]string{"A": "B", "B": "A"}).String())
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("first occurrence order", func(t *testing.T) {
+		f := MustParse("(wet <-> rain) & (rain -> !sun)")
+		assert.Equal(t, "((x1 <-> x2) & (x2 -> !x3))", Normalize(f).String())
+	})
+	t.Run("constant formula", func(t *testing.T) {
+		assert.Equal(t, Top(), Normalize(Top()))
+	})
+}
+
+func TestEqualUpToRenaming(t *testing.T) {
+	t.Run("formulas differing in naming only", func(t *testing.T) {
+		f := MustParse("(A -> B) & (B | !C)")
+		g := MustParse("(q -> p) & (p | !r)")
+		assert.True(t, EqualUpToRenaming(f, g))
+	})
+	t.Run("renaming must be one-to-one", func(t *testing.T) {
+		assert.False(t, EqualUpToRenaming(MustParse("A & B"), MustParse("A & A")))
+		assert.False(t, EqualUpToRenaming(MustParse("A & A"), MustParse("A & B")))
+	})
+	t.Run("structure must be equal", func(t *testing.T) {
+		assert.False(t, EqualUpToRenaming(MustParse("A & B"), MustParse("A | B")))
+		assert.False(t, EqualUpToRenaming(MustParse("A & !B"), MustParse("!A & B")))
+	})
+}
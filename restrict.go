@@ -0,0 +1,106 @@
+package logo
+
+import "fmt"
+
+// Restrict substitutes the variables of the partial assignment by their truth values
+// and folds the resulting constants, e.g., A & false becomes false and true -> B becomes B.
+// The residual formula only contains the variables of f that are not assigned, or it
+// is a Leaf if f is constant under the partial assignment. f is not modified, and the
+// result shares no nodes with f.
+func Restrict(f LogicNode, partial Assignment) LogicNode {
+	return Fold(f, func(node LogicNode, children []LogicNode) LogicNode {
+		switch f1 := node.(type) {
+		case *Variable:
+			if value, ok := partial[f1.Name]; ok {
+				return Leaf(value)
+			}
+			return &Variable{Name: f1.Name}
+		case Leaf:
+			return f1
+		case *NotOp:
+			if l, ok := children[0].(Leaf); ok {
+				return !l
+			}
+			return &NotOp{X: children[0]}
+		case *BinaryOp:
+			return restrictBinary(f1.Op, children[0], children[1])
+		case *NaryOp:
+			return restrictNary(f1.Op, children)
+		default:
+			panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+		}
+	})
+}
+
+// restrictBinary folds the binary operator op if at least one of its operands is constant.
+func restrictBinary(op OpType, x, y LogicNode) LogicNode {
+	lx, xConst := x.(Leaf)
+	ly, yConst := y.(Leaf)
+	switch {
+	case xConst && yConst:
+		return Leaf(BinaryOp{X: lx, Y: ly, Op: op}.Eval(nil))
+	case xConst:
+		return residual(y, func(v Leaf) bool { return BinaryOp{X: lx, Y: v, Op: op}.Eval(nil) })
+	case yConst:
+		return residual(x, func(v Leaf) bool { return BinaryOp{X: v, Y: ly, Op: op}.Eval(nil) })
+	default:
+		return &BinaryOp{X: x, Y: y, Op: op}
+	}
+}
+
+// residual returns the formula g(f), where g is a unary boolean function: a constant,
+// the identity or the negation.
+func residual(f LogicNode, g func(Leaf) bool) LogicNode {
+	onFalse, onTrue := g(false), g(true)
+	switch {
+	case onFalse == onTrue:
+		return Leaf(onTrue)
+	case onTrue:
+		return f
+	default:
+		return &NotOp{X: f}
+	}
+}
+
+// restrictNary removes the constant clauses of a NaryOp. The operator is collapsed to a
+// Leaf if no clauses remain, or to its only clause if constants have been removed.
+func restrictNary(op OpType, children []LogicNode) LogicNode {
+	var clauses []LogicNode
+	folded, parity := false, false
+	for _, child := range children {
+		l, ok := child.(Leaf)
+		if !ok {
+			clauses = append(clauses, child)
+			continue
+		}
+		folded = true
+		switch op {
+		case AndOp:
+			if !l {
+				return Leaf(false)
+			}
+		case OrOp:
+			if l {
+				return Leaf(true)
+			}
+		case XorOp:
+			parity = parity != bool(l)
+		default:
+			panic(fmt.Sprintf("Unknown OpType=%d", op))
+		}
+	}
+
+	var result LogicNode
+	switch {
+	case len(clauses) == 0:
+		return Leaf(op == AndOp || parity)
+	case len(clauses) == 1 && folded:
+		result = clauses[0]
+	default:
+		result = &NaryOp{Clauses: clauses, Op: op}
+	}
+	if parity {
+		return &NotOp{X: result}
+	}
+	return result
+}
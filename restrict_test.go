@@ -0,0 +1,70 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrict(t *testing.T) {
+	t.Run("constant folding of binary operators", func(t *testing.T) {
+		a, b := Var("A"), Var("B")
+		tests := []struct {
+			f        LogicNode
+			partial  Assignment
+			expected string
+		}{
+			{And(a, b), Assignment{"B": false}, "false"},
+			{And(a, b), Assignment{"B": true}, "A"},
+			{Or(a, b), Assignment{"A": true}, "true"},
+			{Implies(a, b), Assignment{"A": true}, "B"},
+			{Implies(a, b), Assignment{"A": false}, "true"},
+			{Implies(a, b), Assignment{"B": false}, "!A"},
+			{Iff(a, b), Assignment{"A": false}, "!B"},
+			{Xor(a, b), Assignment{"B": true}, "!A"},
+			{Nand(a, b), Assignment{"A": true}, "!B"},
+			{Nor(a, b), Assignment{"A": true}, "false"},
+			{ImpliedBy(a, b), Assignment{"A": false}, "!B"},
+			{ImpliedBy(a, b), Assignment{"B": true}, "A"},
+			{Iff(a, b), Assignment{"A": true, "B": false}, "false"},
+			{Implies(a, b), Assignment{"C": true}, "(A -> B)"},
+		}
+		for _, test := range tests {
+			assert.Equal(t, test.expected, Restrict(test.f, test.partial).String(), "%s with %v", test.f, test.partial)
+		}
+	})
+	t.Run("constant folding of n-ary operators", func(t *testing.T) {
+		a, b, c := Var("A"), Var("B"), Var("C")
+		assert.Equal(t, "(A & C)", Restrict(NewConjunction(a, b, c), Assignment{"B": true}).String())
+		assert.Equal(t, "false", Restrict(NewConjunction(a, b, c), Assignment{"B": false}).String())
+		assert.Equal(t, "C", Restrict(NewDisjunction(a, b, c), Assignment{"A": false, "B": false}).String())
+		assert.Equal(t, "!(A ^ C)", Restrict(NewExclusiveDisjunction(a, b, c), Assignment{"B": true}).String())
+		assert.Equal(t, "true", Restrict(NewExclusiveDisjunction(a, b), Assignment{"A": true, "B": false}).String())
+		assert.Equal(t, "true", Restrict(NewConjunction(), Assignment{}).String())
+	})
+	t.Run("constants propagate upwards", func(t *testing.T) {
+		f := MustParse("(A & !B) | (C -> (D <-> E))")
+		assert.Equal(t, "(A | (C -> !E))", Restrict(f, Assignment{"B": false, "D": false}).String())
+		assert.Equal(t, Top(), Restrict(f, Assignment{"C": false}))
+		assert.Equal(t, "(A & !B)", Restrict(f, Assignment{"C": true, "D": true, "E": false}).String())
+	})
+	t.Run("residual formula is equivalent under all extensions", func(t *testing.T) {
+		f := MustParse("((A ^ B) !& (C | !D)) <- (A !| (B -> C))")
+		partial := Assignment{"A": false, "C": true}
+		r := Restrict(f, partial)
+		assert.NotContains(t, r.Scope(), "A")
+		assert.NotContains(t, r.Scope(), "C")
+		for _, b := range []bool{false, true} {
+			for _, d := range []bool{false, true} {
+				full := Assignment{"A": false, "B": b, "C": true, "D": d}
+				assert.Equal(t, f.Eval(full), r.Eval(full))
+			}
+		}
+	})
+	t.Run("input is not modified", func(t *testing.T) {
+		f := And(Var("A"), Not(Var("B")))
+		r := Restrict(f, Assignment{"B": false})
+		assert.Equal(t, "(A & !B)", f.String())
+		assert.NotSame(t, f.(*BinaryOp).X, r)
+	})
+}
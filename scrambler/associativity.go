@@ -4,9 +4,10 @@ import (
 	. "github.com/dmholtz/logo"
 )
 
-// CombineAnd combines a tree of nested ANDs or ORs into a single n-ary operator by applying the associativity rule.
+// CombineAnd combines a tree of nested ANDs, ORs or XORs into a single n-ary operator by applying the associativity rule.
 // Associativity: (A & B) & C = A & (B & C) = A & B & C
 // Associativity: (A | B) | C = A | (B | C) = A | B | C
+// Associativity: (A ^ B) ^ C = A ^ (B ^ C) = A ^ B ^ C
 func Combine(f LogicNode) (LogicNode, bool) {
 	naryOp := NaryOp{}
 
@@ -29,14 +30,14 @@ func Combine(f LogicNode) (LogicNode, bool) {
 
 	switch operator := f.(type) {
 	case *BinaryOp:
-		if operator.Op == AndOp || operator.Op == OrOp {
+		if operator.Op == AndOp || operator.Op == OrOp || operator.Op == XorOp {
 			naryOp.Op = operator.Op
 			extendClauses(operator.X, naryOp.Op)
 			extendClauses(operator.Y, naryOp.Op)
 			return &naryOp, true
 		}
 	case *NaryOp:
-		if operator.Op == AndOp || operator.Op == OrOp {
+		if operator.Op == AndOp || operator.Op == OrOp || operator.Op == XorOp {
 			naryOp.Op = operator.Op
 			for _, clause := range operator.Clauses {
 				extendClauses(clause, naryOp.Op)
@@ -47,14 +48,15 @@ func Combine(f LogicNode) (LogicNode, bool) {
 	return f, false
 }
 
-// SplitNary splits a n-ary conjunction or disjunction into a binary tree of conjunctions or disjunctions.
+// SplitNary splits a n-ary conjunction, disjunction or exclusive disjunction into a binary tree of
+// conjunctions, disjunctions or exclusive disjunctions.
 func SplitNary(f LogicNode) (LogicNode, bool) {
 	switch operator := f.(type) {
 	case *NaryOp:
 		if len(operator.Clauses) == 0 && operator.Op == AndOp {
 			return Top(), true
 		}
-		if len(operator.Clauses) == 0 && operator.Op == OrOp {
+		if len(operator.Clauses) == 0 && (operator.Op == OrOp || operator.Op == XorOp) {
 			return Bottom(), true
 		}
 		if len(operator.Clauses) == 1 {
@@ -70,6 +72,10 @@ func SplitNary(f LogicNode) (LogicNode, bool) {
 			rest, _ := SplitNary(NewDisjunction(operator.Clauses[1:]...))
 			return Or(operator.Clauses[0], rest), true
 		}
+		if operator.Op == XorOp {
+			rest, _ := SplitNary(NewExclusiveDisjunction(operator.Clauses[1:]...))
+			return Xor(operator.Clauses[0], rest), true
+		}
 	}
 	return f, false
 }
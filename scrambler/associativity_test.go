@@ -81,6 +81,22 @@ func TestCombine(t *testing.T) {
 		// assert that the result is equivalent to the original expression
 		assert.True(t, bf.IsEquiv(f, result))
 	})
+	t.Run("(A ^ B) ^ (C ^ D) is combined to (A ^ B ^ C ^ D) ", func(t *testing.T) {
+		f := Xor(Xor(Var("A"), Var("B")), Xor(Var("C"), Var("D")))
+
+		// assert that combination is successful
+		result, ok := Combine(f)
+		assert.True(t, ok)
+
+		// assert that the result is an exclusive disjunction of correct length
+		xor, ok := result.(*NaryOp)
+		assert.True(t, ok)
+		assert.Equal(t, XorOp, xor.Op)
+		assert.Equal(t, 4, len(xor.Clauses))
+
+		// assert that the result is equivalent to the original expression
+		assert.True(t, bf.IsEquiv(f, result))
+	})
 	t.Run("A -> B is not combined", func(t *testing.T) {
 		f := Implies(Var("A"), Var("B"))
 
@@ -170,6 +186,24 @@ func TestSplit(t *testing.T) {
 		// assert that the result is equivalent to the original expression
 		assert.True(t, bf.IsEquiv(f, result))
 	})
+	t.Run("A ^ B ^ C is split to (A ^ (B ^ C))", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Var("A"), Var("B"), Var("C"))
+
+		// assert that split is successful
+		result, ok := SplitNary(f)
+		assert.True(t, ok)
+
+		// assert that the tree grows to the right
+		assert.Equal(t, Xor(Var("A"), Xor(Var("B"), Var("C"))), result)
+
+		// assert that the result is equivalent to the original expression
+		assert.True(t, bf.IsEquiv(f, result))
+	})
+	t.Run("(^) is split to Bottom", func(t *testing.T) {
+		result, ok := SplitNary(NewExclusiveDisjunction())
+		assert.True(t, ok)
+		assert.Equal(t, Bottom(), result)
+	})
 	t.Run("!A is not split", func(t *testing.T) {
 		f := Not(Var("A"))
 
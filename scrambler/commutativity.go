@@ -6,23 +6,24 @@ import (
 	. "github.com/dmholtz/logo"
 )
 
+// Commute swaps the operands of a commutative binary operator or randomly permutes the clauses
+// of an n-ary AND, OR, or XOR. The result is a new node, the input is left unchanged.
 func Commute(f LogicNode) (LogicNode, bool) {
 	switch f1 := f.(type) {
 	case *BinaryOp:
-		// only AND, OR, and IFF are commutative
-		if f1.Op == AndOp || f1.Op == OrOp || f1.Op == IffOp {
-			f1.X, f1.Y = f1.Y, f1.X
-			return f1, true
+		// only AND, OR, IFF, XOR, NAND, and NOR are commutative
+		switch f1.Op {
+		case AndOp, OrOp, IffOp, XorOp, NandOp, NorOp:
+			return &BinaryOp{X: f1.Y, Y: f1.X, Op: f1.Op}, true
 		}
 	case *NaryOp:
-		// only AND and OR are commutative
-		if f1.Op == AndOp || f1.Op == OrOp {
+		// only AND, OR, and XOR are commutative
+		if f1.Op == AndOp || f1.Op == OrOp || f1.Op == XorOp {
 			operands := make([]LogicNode, len(f1.Clauses))
-			copy(operands, f1.Clauses)
 			for i, j := range rand.Perm(len(operands)) {
-				f1.Clauses[i] = operands[j]
+				operands[i] = f1.Clauses[j]
 			}
-			return f1, true
+			return &NaryOp{Clauses: operands, Op: f1.Op}, true
 		}
 	}
 	return f, false
@@ -53,6 +53,33 @@ func TestCommute(t *testing.T) {
 		assert.True(t, bf.IsEquiv(f, result))
 	})
 
+	t.Run("commute switches operands of XOR, NAND, and NOR", func(t *testing.T) {
+		for _, f := range []LogicNode{Xor(Var("A"), Var("B")), Nand(Var("A"), Var("B")), Nor(Var("A"), Var("B"))} {
+			// assert that commutation is successful
+			result, ok := Commute(f)
+			assert.True(t, ok)
+
+			// assert that the result is equivalent to the original expression
+			assert.True(t, bf.IsEquiv(f, result))
+		}
+	})
+
+	t.Run("commute does not switch operands of ImpliedBy", func(t *testing.T) {
+		_, ok := Commute(ImpliedBy(Var("A"), Var("B")))
+		assert.False(t, ok)
+	})
+
+	t.Run("commute leaves the input unchanged", func(t *testing.T) {
+		f := And(Var("A"), Var("B"))
+
+		result, ok := Commute(f)
+		assert.True(t, ok)
+
+		// assert that only the result has swapped operands
+		assert.Equal(t, "(A & B)", f.String())
+		assert.Equal(t, "(B & A)", result.String())
+	})
+
 	t.Run("commute does not switch operands of Implies", func(t *testing.T) {
 		f := Implies(Var("A"), Var("B"))
 
@@ -2,17 +2,25 @@ package scrambler
 
 import . "github.com/dmholtz/logo"
 
-// RemoveIdempotency removes duplicate clauses from a disjunction or conjunction
+// RemoveIdempotency removes duplicate clauses from a disjunction or conjunction.
+// The remaining clauses keep the order of their first occurrence.
+// Duplicate clauses of an exclusive disjunction cancel out in pairs, since A ^ A = false.
 func RemoveIdempotency(f LogicNode) (LogicNode, bool) {
 	switch operator := f.(type) {
 	case *NaryOp:
-		clauses := make(map[string]LogicNode, 0)
-		for _, clause := range operator.Clauses {
-			clauses[clause.String()] = clause
+		if operator.Op == XorOp {
+			return cancelXorPairs(operator), true
 		}
 
+		// group clauses by their hash and compare clauses with equal hashes structurally
+		seen := make(map[uint64][]LogicNode)
 		clauseSlice := make([]LogicNode, 0)
-		for _, clause := range clauses {
+		for _, clause := range operator.Clauses {
+			hash := Hash(clause)
+			if containsEqual(seen[hash], clause) {
+				continue
+			}
+			seen[hash] = append(seen[hash], clause)
 			clauseSlice = append(clauseSlice, clause)
 		}
 
@@ -20,3 +28,48 @@ func RemoveIdempotency(f LogicNode) (LogicNode, bool) {
 	}
 	return f, false
 }
+
+// cancelXorPairs removes the clauses of an exclusive disjunction that occur an even number
+// of times and keeps a single clause of those that occur an odd number of times.
+func cancelXorPairs(operator *NaryOp) LogicNode {
+	// group clauses by their hash and compare clauses with equal hashes structurally
+	seen := make(map[uint64][]int)
+	clauseSlice := make([]LogicNode, 0)
+	odd := make([]bool, 0)
+	for _, clause := range operator.Clauses {
+		hash := Hash(clause)
+		found := false
+		for _, i := range seen[hash] {
+			if Equal(clauseSlice[i], clause) {
+				odd[i], found = !odd[i], true
+				break
+			}
+		}
+		if !found {
+			seen[hash] = append(seen[hash], len(clauseSlice))
+			clauseSlice = append(clauseSlice, clause)
+			odd = append(odd, true)
+		}
+	}
+
+	remaining := make([]LogicNode, 0)
+	for i, clause := range clauseSlice {
+		if odd[i] {
+			remaining = append(remaining, clause)
+		}
+	}
+	if len(remaining) == 0 {
+		return Bottom()
+	}
+	return &NaryOp{Op: XorOp, Clauses: remaining}
+}
+
+// containsEqual returns true iff clauses contains a clause that is structurally equal to f.
+func containsEqual(clauses []LogicNode, f LogicNode) bool {
+	for _, clause := range clauses {
+		if Equal(clause, f) {
+			return true
+		}
+	}
+	return false
+}
@@ -74,6 +74,16 @@ func TestRemoveIdempotency(t *testing.T) {
 		assert.True(t, bf.IsEquiv(f, result))
 	})
 
+	t.Run("keep the order of first occurrence", func(t *testing.T) {
+		f := NewDisjunction(Var("C"), Not(Var("A")), Var("C"), Var("B"), Not(Var("A")))
+
+		result, ok := RemoveIdempotency(f)
+		assert.True(t, ok)
+
+		// assert that the remaining clauses are in the order of their first occurrence
+		assert.Equal(t, NewDisjunction(Var("C"), Not(Var("A")), Var("B")), result)
+	})
+
 	t.Run("leave other operators than NaryOp unchanged", func(t *testing.T) {
 		f := And(Var("A"), Var("B"))
 
@@ -82,4 +92,18 @@ func TestRemoveIdempotency(t *testing.T) {
 		assert.False(t, ok)
 		assert.Equal(t, f, result)
 	})
+
+	t.Run("duplicate clauses of an exclusive disjunction cancel out in pairs", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Var("A"), Var("B"), Var("A"), Var("C"), Var("C"), Var("C"))
+
+		result, ok := RemoveIdempotency(f)
+		assert.True(t, ok)
+		assert.Equal(t, NewExclusiveDisjunction(Var("B"), Var("C")), result)
+		assert.True(t, bf.IsEquiv(f, result))
+
+		// assert that an exclusive disjunction of pairs is false
+		result, ok = RemoveIdempotency(NewExclusiveDisjunction(Var("A"), Var("A")))
+		assert.True(t, ok)
+		assert.Equal(t, Bottom(), result)
+	})
 }
@@ -0,0 +1,48 @@
+package scrambler
+
+import (
+	"math/rand"
+
+	. "github.com/dmholtz/logo"
+)
+
+// Laws is a collection of tautologies whose variables serve as placeholders for arbitrary
+// formulas, i.e., every instance of a law is a tautology as well.
+var Laws = []LogicNode{
+	MustParse("A | !A"),                  // excluded middle
+	MustParse("!(A & !A)"),               // non-contradiction
+	MustParse("A -> A"),                  // identity
+	MustParse("A -> (A | B)"),            // addition
+	MustParse("(A & B) -> A"),            // simplification
+	MustParse("(A & (A -> B)) -> B"),     // modus ponens
+	MustParse("(A -> B) <-> (!B -> !A)"), // contraposition
+	MustParse("!!A <-> A"),               // double negation
+	MustParse("(A | (A & B)) <-> A"),     // absorption
+}
+
+// InstantiateLaw returns an instance of the law: each of its variables is replaced by a
+// randomly chosen subformula of f (see Substitute). Neither law nor f is modified.
+func InstantiateLaw(law LogicNode, f LogicNode) LogicNode {
+	subformulas := make([]LogicNode, 0)
+	Inspect(f, func(node LogicNode) bool {
+		if node != nil {
+			subformulas = append(subformulas, node)
+		}
+		return true
+	})
+
+	substitution := make(map[string]LogicNode)
+	for _, name := range Vars(law) {
+		substitution[name] = subformulas[rand.Intn(len(subformulas))]
+	}
+	return Substitute(law, substitution)
+}
+
+// AddInstantiatedLaw conjoins f with a random law from Laws that is instantiated with
+// subformulas of f. The result is equivalent to f, since the instance is a tautology.
+//
+// Caveat: apply this function to the root of a formula only, since the result contains f.
+func AddInstantiatedLaw(f LogicNode) (LogicNode, bool) {
+	law := Laws[rand.Intn(len(Laws))]
+	return And(f, InstantiateLaw(law, f)), true
+}
@@ -0,0 +1,50 @@
+package scrambler
+
+import (
+	"testing"
+
+	. "github.com/dmholtz/logo"
+	bf "github.com/dmholtz/logo/brute_force"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaws(t *testing.T) {
+	t.Run("all laws are tautologies", func(t *testing.T) {
+		for _, law := range Laws {
+			assert.True(t, bf.IsTaut(law), law.String())
+		}
+	})
+}
+
+func TestInstantiateLaw(t *testing.T) {
+	t.Run("instances of laws are tautologies", func(t *testing.T) {
+		f := Implies(And(Var("X"), Not(Var("Y"))), NewDisjunction(Var("Z"), Var("X")))
+		for _, law := range Laws {
+			for i := 0; i < 10; i++ {
+				instance := InstantiateLaw(law, f)
+				assert.True(t, bf.IsTaut(instance), instance.String())
+				for name := range instance.Scope() {
+					assert.Contains(t, f.Scope(), name)
+				}
+			}
+		}
+	})
+	t.Run("inputs are not modified", func(t *testing.T) {
+		law := MustParse("A -> (A | B)")
+		f := Xor(Var("X"), Var("Y"))
+		_ = InstantiateLaw(law, f)
+		assert.Equal(t, "(A -> (A | B))", law.String())
+		assert.Equal(t, "(X ^ Y)", f.String())
+	})
+}
+
+func TestAddInstantiatedLaw(t *testing.T) {
+	t.Run("result is equivalent", func(t *testing.T) {
+		f := Or(Not(Var("A")), And(Var("B"), Var("C")))
+		for i := 0; i < 20; i++ {
+			result, ok := AddInstantiatedLaw(f)
+			assert.True(t, ok)
+			assert.True(t, bf.IsEquiv(f, result))
+		}
+	})
+}
@@ -1,35 +1,54 @@
 package scrambler
 
 import (
+	"math"
+
 	. "github.com/dmholtz/logo"
 )
 
-// Simplify removes double negations, combines operators, and removes idempotency
+// Simplify removes double negations, combines operators, and removes idempotency.
+// Like all compositions in this file, it returns a new formula and leaves f unchanged.
 func Simplify(f LogicNode) LogicNode {
-	f = Traverse(f, RemoveDoubleNegation)
-	f = Traverse(f, Combine)
-	f = Traverse(f, RemoveIdempotency)
+	f = TraverseImmutable(f, RemoveDoubleNegation)
+	f = TraverseImmutable(f, Combine)
+	f = TraverseImmutable(f, RemoveIdempotency)
 	return f
 }
 
 // SubstituteArrows inserts implication and equivalence operators where possible
 func SubstituteArrows(f LogicNode) LogicNode {
-	f = Traverse(f, SplitNary)
-	f = Traverse(f, SubstituteByImplies)
-	f = Traverse(f, SubstituteByIff)
+	f = TraverseImmutable(f, SplitNary)
+	f = TraverseImmutable(f, SubstituteByImplies)
+	f = TraverseImmutable(f, SubstituteByIff)
 	return f
 }
 
 // DeMorganIteration applies iteratively applies DeMorgan's laws to a formula
 func DeMorganIteration(f LogicNode) LogicNode {
-	f = Traverse(f, RemoveIff)
-	f = Traverse(f, RemoveImplies)
+	return DeMorganIterationWithBudget(f, math.MaxInt)
+}
+
+// DeMorganIterationWithBudget works like DeMorganIteration but stops iterating as soon as
+// an iteration would exceed maxSize nodes (see Stats). The budget does not restrict the
+// removal of the additional connectives, which happens before the first iteration.
+func DeMorganIterationWithBudget(f LogicNode, maxSize int) LogicNode {
+	f = TraverseImmutable(f, SplitNary)
+	f = TraverseImmutable(f, RemoveXor)
+	f = TraverseImmutable(f, RemoveNand)
+	f = TraverseImmutable(f, RemoveNor)
+	f = TraverseImmutable(f, RemoveImpliedBy)
+	f = TraverseImmutable(f, RemoveIff)
+	f = TraverseImmutable(f, RemoveImplies)
 	f = Simplify(f)
-	f = Traverse(f, SplitNary)
+	f = TraverseImmutable(f, SplitNary)
 
 	for i := 0; i < 5; i++ {
-		f = TraverseProbabilistic(f, DeMorganExpandEager, 0.5)
-		f = Traverse(f, RemoveDoubleNegation)
+		next := TraverseProbabilisticImmutable(f, DeMorganExpandEager, 0.5)
+		next = TraverseImmutable(next, RemoveDoubleNegation)
+		if Stats(next).Size > maxSize {
+			break
+		}
+		f = next
 	}
 
 	return f
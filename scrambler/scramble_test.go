@@ -17,6 +17,24 @@ func TestSimplify(t *testing.T) {
 		// assert that the result is semantically equivalent to the input
 		assert.True(t, bf.IsEquiv(f, result))
 	})
+	t.Run("simplify leaves the input unchanged", func(t *testing.T) {
+		f := NewConjunction(Not(Not(Var("A"))), And(Var("A"), Var("B")), Var("B"))
+		original := Clone(f)
+
+		_ = Simplify(f)
+
+		assert.Equal(t, original, f)
+	})
+	t.Run("simplify cancels duplicate clauses of exclusive disjunctions", func(t *testing.T) {
+		a, b := Var("A"), Var("B")
+		for _, f := range []LogicNode{Xor(a, Xor(a, b)), Xor(a, a), NewExclusiveDisjunction(a, b, Not(Not(a)), b, a)} {
+			result := Simplify(f)
+
+			// assert that the result is semantically equivalent to the input
+			assert.True(t, bf.IsEquiv(f, result), "%s simplified to %s", f, result)
+		}
+		assert.Equal(t, "(B)", Simplify(Xor(a, Xor(a, b))).String())
+	})
 }
 
 func TestSubstituteArrows(t *testing.T) {
@@ -39,4 +57,32 @@ func TestDeMorganIteration(t *testing.T) {
 		// assert that the result is semantically equivalent to the input
 		assert.True(t, bf.IsEquiv(f, result))
 	})
+	t.Run("DeMorganIteration removes additional connectives", func(t *testing.T) {
+		f := NewExclusiveDisjunction(Nand(Var("A"), Var("B")), Nor(Var("B"), Var("C")), ImpliedBy(Var("C"), Var("A")))
+
+		result := DeMorganIteration(f)
+
+		// assert that the result is semantically equivalent to the input
+		assert.True(t, bf.IsEquiv(f, result))
+	})
+	t.Run("DeMorganIteration leaves the input unchanged", func(t *testing.T) {
+		f := Not(NewDisjunction(Implies(Var("A"), Var("B")), Iff(Var("B"), Var("C")), Not(Not(Var("D")))))
+		original := Clone(f)
+
+		_ = DeMorganIteration(f)
+
+		assert.Equal(t, original, f)
+	})
+	t.Run("DeMorganIterationWithBudget respects the size budget", func(t *testing.T) {
+		f := Not(NewConjunction(Or(Var("A"), Var("B")), Not(And(Var("C"), Var("D"))), Var("E")))
+		prepared := TraverseImmutable(Simplify(TraverseImmutable(f, SplitNary)), SplitNary)
+		budget := Stats(prepared).Size
+
+		for i := 0; i < 10; i++ {
+			result := DeMorganIterationWithBudget(f, budget)
+
+			assert.True(t, bf.IsEquiv(f, result))
+			assert.LessOrEqual(t, Stats(result).Size, budget)
+		}
+	})
 }
@@ -30,12 +30,8 @@ func SubstituteByIff(f LogicNode) (LogicNode, bool) {
 			if impliesNode1, ok := andNode.X.(*BinaryOp); ok {
 				if impliesNode2, ok := andNode.Y.(*BinaryOp); ok {
 					if impliesNode1.Op == IfOp && impliesNode2.Op == IfOp {
-						implies1XSig := impliesNode1.X.String()
-						implies1YSig := impliesNode1.Y.String()
-						implies2XSig := impliesNode2.X.String()
-						implies2YSig := impliesNode2.Y.String()
-						// compare subformulas by their string representation (signature)
-						if implies1XSig == implies2YSig && implies1YSig == implies2XSig {
+						// compare subformulas structurally
+						if Equal(impliesNode1.X, impliesNode2.Y) && Equal(impliesNode1.Y, impliesNode2.X) {
 							return Iff(impliesNode1.X, impliesNode1.Y), true
 						}
 					}
@@ -65,3 +61,83 @@ func RemoveIff(f LogicNode) (LogicNode, bool) {
 	}
 	return f, false
 }
+
+// RemoveXor substitutes the logic formula (A XOR B) with !(A<->B) if possible.
+func RemoveXor(f LogicNode) (LogicNode, bool) {
+	if xorNode, ok := f.(*BinaryOp); ok {
+		if xorNode.Op == XorOp {
+			return Not(Iff(xorNode.X, xorNode.Y)), true
+		}
+	}
+	return f, false
+}
+
+// SubstituteByXor substitutes the logic formula !(A<->B) with (A XOR B) if possible.
+func SubstituteByXor(f LogicNode) (LogicNode, bool) {
+	if notNode, ok := f.(*NotOp); ok {
+		if iffNode, ok := notNode.X.(*BinaryOp); ok && iffNode.Op == IffOp {
+			return Xor(iffNode.X, iffNode.Y), true
+		}
+	}
+	return f, false
+}
+
+// RemoveNand substitutes the logic formula (A NAND B) with !(A AND B) if possible.
+func RemoveNand(f LogicNode) (LogicNode, bool) {
+	if nandNode, ok := f.(*BinaryOp); ok {
+		if nandNode.Op == NandOp {
+			return Not(And(nandNode.X, nandNode.Y)), true
+		}
+	}
+	return f, false
+}
+
+// SubstituteByNand substitutes the logic formula !(A AND B) with (A NAND B) if possible.
+func SubstituteByNand(f LogicNode) (LogicNode, bool) {
+	if notNode, ok := f.(*NotOp); ok {
+		if andNode, ok := notNode.X.(*BinaryOp); ok && andNode.Op == AndOp {
+			return Nand(andNode.X, andNode.Y), true
+		}
+	}
+	return f, false
+}
+
+// RemoveNor substitutes the logic formula (A NOR B) with !(A OR B) if possible.
+func RemoveNor(f LogicNode) (LogicNode, bool) {
+	if norNode, ok := f.(*BinaryOp); ok {
+		if norNode.Op == NorOp {
+			return Not(Or(norNode.X, norNode.Y)), true
+		}
+	}
+	return f, false
+}
+
+// SubstituteByNor substitutes the logic formula !(A OR B) with (A NOR B) if possible.
+func SubstituteByNor(f LogicNode) (LogicNode, bool) {
+	if notNode, ok := f.(*NotOp); ok {
+		if orNode, ok := notNode.X.(*BinaryOp); ok && orNode.Op == OrOp {
+			return Nor(orNode.X, orNode.Y), true
+		}
+	}
+	return f, false
+}
+
+// RemoveImpliedBy substitutes the logic formula (A<-B) with (B->A) if possible.
+func RemoveImpliedBy(f LogicNode) (LogicNode, bool) {
+	if impliedByNode, ok := f.(*BinaryOp); ok {
+		if impliedByNode.Op == ImpliedByOp {
+			return Implies(impliedByNode.Y, impliedByNode.X), true
+		}
+	}
+	return f, false
+}
+
+// SubstituteByImpliedBy substitutes the logic formula (A->B) with (B<-A) if possible.
+func SubstituteByImpliedBy(f LogicNode) (LogicNode, bool) {
+	if impliesNode, ok := f.(*BinaryOp); ok {
+		if impliesNode.Op == IfOp {
+			return ImpliedBy(impliesNode.Y, impliesNode.X), true
+		}
+	}
+	return f, false
+}
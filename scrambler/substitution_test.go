@@ -168,3 +168,41 @@ func TestRemoveIff(t *testing.T) {
 		assert.Equal(t, &f, result)
 	})
 }
+
+func TestAdditionalConnectiveSubstitutions(t *testing.T) {
+	rules := []struct {
+		name     string
+		rule     func(LogicNode) (LogicNode, bool)
+		input    LogicNode
+		expected LogicNode
+	}{
+		{"A XOR B is substituted by !(A<->B)", RemoveXor, Xor(Var("A"), Var("B")), Not(Iff(Var("A"), Var("B")))},
+		{"!(A<->B) is substituted by A XOR B", SubstituteByXor, Not(Iff(Var("A"), Var("B"))), Xor(Var("A"), Var("B"))},
+		{"A NAND B is substituted by !(A AND B)", RemoveNand, Nand(Var("A"), Var("B")), Not(And(Var("A"), Var("B")))},
+		{"!(A AND B) is substituted by A NAND B", SubstituteByNand, Not(And(Var("A"), Var("B"))), Nand(Var("A"), Var("B"))},
+		{"A NOR B is substituted by !(A OR B)", RemoveNor, Nor(Var("A"), Var("B")), Not(Or(Var("A"), Var("B")))},
+		{"!(A OR B) is substituted by A NOR B", SubstituteByNor, Not(Or(Var("A"), Var("B"))), Nor(Var("A"), Var("B"))},
+		{"A<-B is substituted by B->A", RemoveImpliedBy, ImpliedBy(Var("A"), Var("B")), Implies(Var("B"), Var("A"))},
+		{"A->B is substituted by B<-A", SubstituteByImpliedBy, Implies(Var("A"), Var("B")), ImpliedBy(Var("B"), Var("A"))},
+	}
+	for _, r := range rules {
+		t.Run(r.name, func(t *testing.T) {
+			// assert that the substitution is successful
+			result, ok := r.rule(r.input)
+			assert.True(t, ok)
+			assert.Equal(t, r.expected, result)
+
+			// assert that result yields a semantically equivalent formula
+			assert.True(t, bf.IsEquiv(r.input, result))
+		})
+	}
+
+	t.Run("other operators are not substituted", func(t *testing.T) {
+		f := And(Var("A"), Var("B"))
+		for _, r := range rules {
+			result, ok := r.rule(f)
+			assert.False(t, ok)
+			assert.Equal(t, f, result)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package scrambler
+
+import (
+	. "github.com/dmholtz/logo"
+)
+
+// Applicable returns the positions of all subformulas of f where the transform function
+// applies, in depth-first pre-order (see Subformulas).
+//
+// Caveat: the transform function must not modify its argument.
+func Applicable(f LogicNode, transform func(LogicNode) (LogicNode, bool)) []Position {
+	positions := make([]Position, 0)
+	for _, s := range Subformulas(f) {
+		if _, ok := transform(s.Node); ok {
+			positions = append(positions, s.Position)
+		}
+	}
+	return positions
+}
+
+// ApplyAt applies the transform function to the subformula of f at the given position only,
+// e.g., to apply DeMorgan's law at the second conjunct. It returns false if the position
+// does not exist or the transform function does not apply. f is not modified.
+//
+// Caveat: the transform function must not modify its argument.
+func ApplyAt(f LogicNode, pos Position, transform func(LogicNode) (LogicNode, bool)) (LogicNode, bool) {
+	node, ok := At(f, pos)
+	if !ok {
+		return f, false
+	}
+	node, ok = transform(node)
+	if !ok {
+		return f, false
+	}
+	return ReplaceAt(f, pos, node)
+}
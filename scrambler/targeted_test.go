@@ -0,0 +1,41 @@
+package scrambler
+
+import (
+	"testing"
+
+	. "github.com/dmholtz/logo"
+	bf "github.com/dmholtz/logo/brute_force"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicable(t *testing.T) {
+	t.Run("finds all positions where DeMorgan applies", func(t *testing.T) {
+		f := NewConjunction(Var("A"), Not(Or(Var("B"), Var("C"))), Not(Not(And(Var("D"), Var("E")))))
+		assert.Equal(t, []Position{{1}, {2, 0}}, Applicable(f, DeMorganExpand))
+	})
+	t.Run("no positions", func(t *testing.T) {
+		assert.Empty(t, Applicable(And(Var("A"), Var("B")), RemoveDoubleNegation))
+	})
+}
+
+func TestApplyAt(t *testing.T) {
+	t.Run("applies DeMorgan at the second conjunct", func(t *testing.T) {
+		f := NewConjunction(Not(And(Var("A"), Var("B"))), Not(Or(Var("B"), Var("C"))))
+		result, ok := ApplyAt(f, Position{1}, DeMorganExpand)
+		assert.True(t, ok)
+		assert.Equal(t, "(!(A & B) & (!B & !C))", result.String())
+		assert.True(t, bf.IsEquiv(f, result))
+		assert.Equal(t, "(!(A & B) & !(B | C))", f.String())
+	})
+	t.Run("transform does not apply", func(t *testing.T) {
+		f := And(Var("A"), Not(Var("B")))
+		result, ok := ApplyAt(f, Position{1}, DeMorganExpand)
+		assert.False(t, ok)
+		assert.Same(t, f, result)
+	})
+	t.Run("invalid position", func(t *testing.T) {
+		f := And(Var("A"), Not(Var("B")))
+		_, ok := ApplyAt(f, Position{3}, AddDoubleNegation)
+		assert.False(t, ok)
+	})
+}
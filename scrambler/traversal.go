@@ -8,35 +8,23 @@ import (
 	. "github.com/dmholtz/logo"
 )
 
-// Traverse() traverses the formula tree and applies the transform function to each node
+// Traverse() traverses the formula tree and applies the transform function to each node.
+// The formula is modified in place; use TraverseImmutable to leave it unchanged.
 func Traverse(f LogicNode, transform func(LogicNode) (LogicNode, bool)) LogicNode {
 	// apply the transform function to the current node
 	f, _ = transform(f)
 
 	// traverse the node's children recursively
-	switch f1 := f.(type) {
-	case *NotOp:
-		f1.X = Traverse(f1.X, transform)
-		return f1
-	case *BinaryOp:
-		f1.X = Traverse(f1.X, transform)
-		f1.Y = Traverse(f1.Y, transform)
-		return f1
-	case *Variable:
-		return f
-	case Leaf:
-		return f
-	case *NaryOp:
-		for i, c := range f1.Clauses {
-			f1.Clauses[i] = Traverse(c, transform)
-		}
-		return f1
-	default:
-		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	children := Children(f)
+	for i, c := range children {
+		children[i] = Traverse(c, transform)
 	}
+	setChildren(f, children)
+	return f
 }
 
-// TraverseProbabilistic() traverses the formula tree and applies the transform function to each node with a given probability
+// TraverseProbabilistic() traverses the formula tree and applies the transform function to each node with a given probability.
+// The formula is modified in place; use TraverseProbabilisticImmutable to leave it unchanged.
 func TraverseProbabilistic(f LogicNode, transform func(LogicNode) (LogicNode, bool), probability float64) LogicNode {
 	if probability < 0 || probability > 1 {
 		panic(fmt.Sprintf("Probability must be between 0 and 1, but is %f", probability))
@@ -47,24 +35,68 @@ func TraverseProbabilistic(f LogicNode, transform func(LogicNode) (LogicNode, bo
 		f, _ = transform(f)
 	}
 
+	children := Children(f)
+	for i, c := range children {
+		children[i] = TraverseProbabilistic(c, transform, probability)
+	}
+	setChildren(f, children)
+	return f
+}
+
+// TraverseImmutable() works like Traverse() but never modifies the input formula. Nodes whose
+// children change are copied (copy-on-write), unchanged subformulas are shared with the input.
+//
+// Caveat: the transform function must not modify its argument either.
+func TraverseImmutable(f LogicNode, transform func(LogicNode) (LogicNode, bool)) LogicNode {
+	// apply the transform function to the current node
+	f, _ = transform(f)
+
+	// traverse the node's children recursively
+	return withChildren(f, func(child LogicNode) LogicNode {
+		return TraverseImmutable(child, transform)
+	})
+}
+
+// TraverseProbabilisticImmutable() works like TraverseProbabilistic() but never modifies the
+// input formula (see TraverseImmutable()).
+func TraverseProbabilisticImmutable(f LogicNode, transform func(LogicNode) (LogicNode, bool), probability float64) LogicNode {
+	if probability < 0 || probability > 1 {
+		panic(fmt.Sprintf("Probability must be between 0 and 1, but is %f", probability))
+	}
+
+	// apply the transform function to the current node with the given probability
+	if rand.Float64() < probability {
+		f, _ = transform(f)
+	}
+
+	return withChildren(f, func(child LogicNode) LogicNode {
+		return TraverseProbabilisticImmutable(child, transform, probability)
+	})
+}
+
+// withChildren returns the node f with each child c replaced by visit(c). The node is
+// copied if any of its children changes, otherwise f itself is returned.
+func withChildren(f LogicNode, visit func(LogicNode) LogicNode) LogicNode {
+	children := Children(f)
+	changed := false
+	for i, c := range children {
+		children[i] = visit(c)
+		changed = changed || children[i] != c
+	}
+	if !changed {
+		return f
+	}
+	return WithChildren(f, children)
+}
+
+// setChildren replaces the children of the node f in place.
+func setChildren(f LogicNode, children []LogicNode) {
 	switch f1 := f.(type) {
 	case *NotOp:
-		f1.X = TraverseProbabilistic(f1.X, transform, probability)
-		return f1
+		f1.X = children[0]
 	case *BinaryOp:
-		f1.X = TraverseProbabilistic(f1.X, transform, probability)
-		f1.Y = TraverseProbabilistic(f1.Y, transform, probability)
-		return f1
-	case *Variable:
-		return f
-	case Leaf:
-		return f
+		f1.X, f1.Y = children[0], children[1]
 	case *NaryOp:
-		for i, c := range f1.Clauses {
-			f1.Clauses[i] = TraverseProbabilistic(c, transform, probability)
-		}
-		return f1
-	default:
-		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+		copy(f1.Clauses, children)
 	}
 }
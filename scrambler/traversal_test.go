@@ -52,3 +52,49 @@ func TestTraversalWithProbability(t *testing.T) {
 		assert.Panics(t, func() { TraverseProbabilistic(f, replaceVariable, 1.1) })
 	})
 }
+
+func TestTraversalImmutable(t *testing.T) {
+
+	t.Run("TraverseImmutable leaves the input unchanged", func(t *testing.T) {
+		f := NewConjunction(And(Var("A"), Or(Var("B"), Var("C"))), Not(Var("D")), Bottom())
+		original := Clone(f)
+		result := TraverseImmutable(f, replaceVariable)
+
+		// assert that the variable D is the only variable in the result
+		assert.Equal(t, 1, len(result.Scope()))
+		_, ok := result.Scope()["D"]
+		assert.True(t, ok)
+
+		// assert that the input has not been modified
+		assert.Equal(t, original, f)
+	})
+
+	t.Run("TraverseImmutable shares unchanged subformulas", func(t *testing.T) {
+		unchanged := Not(Top())
+		f := And(unchanged, Var("A"))
+		result := TraverseImmutable(f, replaceVariable).(*BinaryOp)
+
+		assert.Same(t, unchanged, result.X)
+		assert.NotSame(t, f, result)
+	})
+
+	t.Run("TraverseImmutable returns the input if nothing changes", func(t *testing.T) {
+		f := NewDisjunction(Top(), Not(Bottom()))
+		assert.Same(t, f, TraverseImmutable(f, replaceVariable))
+	})
+
+	t.Run("TraverseProbabilisticImmutable leaves the input unchanged", func(t *testing.T) {
+		f := NewConjunction(And(Var("A"), Or(Var("B"), Var("C"))), Not(Var("D")), Bottom())
+		original := Clone(f)
+		result := TraverseProbabilisticImmutable(f, replaceVariable, 1)
+
+		assert.Equal(t, 1, len(result.Scope()))
+		assert.Equal(t, original, f)
+	})
+
+	t.Run("Panic if the probability is out of range", func(t *testing.T) {
+		f := And(Var("A"), Var("B"))
+		assert.Panics(t, func() { TraverseProbabilisticImmutable(f, replaceVariable, -0.1) })
+		assert.Panics(t, func() { TraverseProbabilisticImmutable(f, replaceVariable, 1.1) })
+	})
+}
@@ -0,0 +1,72 @@
+package logo
+
+// Statistics are metrics of a formula that measure its complexity.
+type Statistics struct {
+	Size      int            // number of nodes
+	Depth     int            // see Depth
+	Operators map[OpType]int // number of binary and n-ary operators per OpType
+	Negations int            // number of NotOps
+	// Occurrences counts the literal occurrences per variable name.
+	Occurrences map[string]int
+	// AlternationDepth is the maximum number of alternating blocks of binary or n-ary operators
+	// on a path from the root to a leaf, e.g., 2 for (A & B & C) | D. Operators of the same
+	// type form one block, negations are ignored.
+	AlternationDepth int
+}
+
+// Stats computes the statistics of the formula f.
+func Stats(f LogicNode) Statistics {
+	stats := Statistics{
+		Size:        Size(f),
+		Depth:       Depth(f),
+		Operators:   make(map[OpType]int),
+		Occurrences: make(map[string]int),
+	}
+	Inspect(f, func(node LogicNode) bool {
+		switch f1 := node.(type) {
+		case *NotOp:
+			stats.Negations++
+		case *BinaryOp:
+			stats.Operators[f1.Op]++
+		case *NaryOp:
+			stats.Operators[f1.Op]++
+		case *Variable:
+			stats.Occurrences[f1.Name]++
+		}
+		return true
+	})
+	stats.AlternationDepth = Fold(f, alternation).depth
+	return stats
+}
+
+// block is the alternation depth of a subformula and the operator of its topmost block.
+type block struct {
+	depth int
+	op    OpType
+	hasOp bool
+}
+
+func alternation(node LogicNode, children []block) block {
+	var op OpType
+	switch f1 := node.(type) {
+	case *NotOp:
+		return children[0]
+	case *BinaryOp:
+		op = f1.Op
+	case *NaryOp:
+		op = f1.Op
+	default:
+		return block{}
+	}
+	result := block{depth: 1, op: op, hasOp: true}
+	for _, child := range children {
+		depth := child.depth
+		if !child.hasOp || child.op != op {
+			depth++
+		}
+		if depth > result.depth {
+			result.depth = depth
+		}
+	}
+	return result
+}
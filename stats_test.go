@@ -0,0 +1,43 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	t.Run("variable", func(t *testing.T) {
+		stats := Stats(Var("A"))
+		assert.Equal(t, Statistics{
+			Size:        1,
+			Operators:   map[OpType]int{},
+			Occurrences: map[string]int{"A": 1},
+		}, stats)
+	})
+	t.Run("compound formula", func(t *testing.T) {
+		f := Implies(NewConjunction(Var("A"), Not(Var("B")), Var("A")), Or(Not(Not(Var("C"))), Top()))
+		stats := Stats(f)
+		assert.Equal(t, 11, stats.Size)
+		assert.Equal(t, 4, stats.Depth)
+		assert.Equal(t, map[OpType]int{IfOp: 1, AndOp: 1, OrOp: 1}, stats.Operators)
+		assert.Equal(t, 3, stats.Negations)
+		assert.Equal(t, map[string]int{"A": 2, "B": 1, "C": 1}, stats.Occurrences)
+		assert.Equal(t, 2, stats.AlternationDepth)
+	})
+	t.Run("alternation depth", func(t *testing.T) {
+		tests := map[string]int{
+			"A":                     0,
+			"!A":                    0,
+			"A & B":                 1,
+			"(A & B) & (C & !D)":    1,
+			"(A & B & C) | D":       2,
+			"!(A & B) | D":          2,
+			"((A | B) & C) | D":     3,
+			"(A -> (B -> C)) <-> D": 2,
+		}
+		for s, expected := range tests {
+			assert.Equal(t, expected, Stats(MustParse(s)).AlternationDepth, s)
+		}
+	})
+}
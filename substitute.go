@@ -0,0 +1,17 @@
+package logo
+
+// Substitute replaces each variable of f whose name is a key of the substitution by a
+// copy of the corresponding formula. All variables are replaced simultaneously, i.e.,
+// variables inside the inserted formulas are not substituted again. Neither f nor the
+// substituted formulas are modified, and the result shares no nodes with them.
+func Substitute(f LogicNode, substitution map[string]LogicNode) LogicNode {
+	return Fold(f, func(node LogicNode, children []LogicNode) LogicNode {
+		if v, ok := node.(*Variable); ok {
+			if g, ok := substitution[v.Name]; ok {
+				return Clone(g)
+			}
+			return &Variable{Name: v.Name}
+		}
+		return WithChildren(node, children)
+	})
+}
@@ -0,0 +1,37 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstitute(t *testing.T) {
+	t.Run("replaces all occurrences of a variable", func(t *testing.T) {
+		f := MustParse("A -> (B | !A)")
+		g := Substitute(f, map[string]LogicNode{"A": And(Var("B"), Var("C"))})
+		assert.Equal(t, "((B & C) -> (B | !(B & C)))", g.String())
+	})
+	t.Run("replacement is simultaneous", func(t *testing.T) {
+		f := MustParse("A & !B")
+		g := Substitute(f, map[string]LogicNode{"A": Var("B"), "B": Var("A")})
+		assert.Equal(t, "(B & !A)", g.String())
+	})
+	t.Run("all node types", func(t *testing.T) {
+		f := Iff(NewDisjunction(Var("A"), Top(), Nand(Var("B"), Var("A"))), NewConjunction())
+		g := Substitute(f, map[string]LogicNode{"A": Bottom()})
+		assert.Equal(t, "((false | true | (B !& false)) <-> true)", g.String())
+	})
+	t.Run("inputs are not modified and not shared", func(t *testing.T) {
+		f := And(Var("A"), Var("A"))
+		sub := Not(Var("C"))
+		g := Substitute(f, map[string]LogicNode{"A": sub}).(*BinaryOp)
+		assert.Equal(t, "(A & A)", f.String())
+		assert.NotSame(t, g.X, g.Y)
+		assert.NotSame(t, sub, g.X)
+
+		g.X.(*NotOp).X = Var("D")
+		assert.Equal(t, "!C", sub.String())
+		assert.Equal(t, "(!D & !C)", g.String())
+	})
+}
@@ -0,0 +1,133 @@
+package logo
+
+import "fmt"
+
+// Tri is a truth value of three-valued logic.
+type Tri int8
+
+const (
+	TriFalse   Tri = 0
+	TriUnknown Tri = 1
+	TriTrue    Tri = 2
+)
+
+// TriOf converts a truth value of two-valued logic.
+func TriOf(b bool) Tri {
+	if b {
+		return TriTrue
+	}
+	return TriFalse
+}
+
+func (t Tri) String() string {
+	switch t {
+	case TriFalse:
+		return "false"
+	case TriUnknown:
+		return "unknown"
+	case TriTrue:
+		return "true"
+	default:
+		panic(fmt.Sprintf("Unknown Tri=%d", int8(t)))
+	}
+}
+
+// Semantics selects the truth functions of three-valued logic.
+type Semantics int
+
+const (
+	// KleeneSemantics is the strong logic of indeterminacy: an operator is unknown unless the known
+	// operands determine its value, e.g., TriUnknown -> TriUnknown is TriUnknown.
+	KleeneSemantics Semantics = iota
+	// LukasiewiczSemantics works like KleeneSemantics but TriUnknown -> TriUnknown and TriUnknown <-> TriUnknown are TriTrue.
+	LukasiewiczSemantics
+)
+
+// EvalPartial evaluates the formula f with KleeneSemantics. Variables that are missing
+// from the assignment are TriUnknown.
+func EvalPartial(f LogicNode, assignment Assignment) Tri {
+	return EvalPartialWith(f, assignment, KleeneSemantics)
+}
+
+// EvalPartialWith evaluates the formula f with the given semantics. Variables that are
+// missing from the assignment are TriUnknown.
+func EvalPartialWith(f LogicNode, assignment Assignment, semantics Semantics) Tri {
+	switch f1 := f.(type) {
+	case *Variable:
+		if value, ok := assignment[f1.Name]; ok {
+			return TriOf(value)
+		}
+		return TriUnknown
+	case Leaf:
+		return TriOf(bool(f1))
+	case *NotOp:
+		return triNot(EvalPartialWith(f1.X, assignment, semantics))
+	case *BinaryOp:
+		x := EvalPartialWith(f1.X, assignment, semantics)
+		y := EvalPartialWith(f1.Y, assignment, semantics)
+		return triBinary(f1.Op, x, y, semantics)
+	case *NaryOp:
+		result := TriOf(bool(emptyNaryValue(f1.Op)))
+		for _, clause := range f1.Clauses {
+			result = triBinary(f1.Op, result, EvalPartialWith(clause, assignment, semantics), semantics)
+		}
+		return result
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+// The truth values are ordered TriFalse < TriUnknown < TriTrue, such that conjunction is the
+// minimum, disjunction is the maximum and negation is the reflection 2 - x.
+
+func triNot(x Tri) Tri {
+	return TriTrue - x
+}
+
+func triMin(x, y Tri) Tri {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func triMax(x, y Tri) Tri {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+func triImplies(x, y Tri, semantics Semantics) Tri {
+	switch semantics {
+	case KleeneSemantics:
+		return triMax(triNot(x), y)
+	case LukasiewiczSemantics:
+		return triMin(TriTrue, TriTrue-x+y)
+	default:
+		panic(fmt.Sprintf("Unknown Semantics=%d", semantics))
+	}
+}
+
+func triBinary(op OpType, x, y Tri, semantics Semantics) Tri {
+	switch op {
+	case AndOp:
+		return triMin(x, y)
+	case OrOp:
+		return triMax(x, y)
+	case IfOp:
+		return triImplies(x, y, semantics)
+	case IffOp:
+		return triMin(triImplies(x, y, semantics), triImplies(y, x, semantics))
+	case XorOp:
+		return triNot(triMin(triImplies(x, y, KleeneSemantics), triImplies(y, x, KleeneSemantics)))
+	case NandOp:
+		return triNot(triMin(x, y))
+	case NorOp:
+		return triNot(triMax(x, y))
+	case ImpliedByOp:
+		return triImplies(y, x, semantics)
+	default:
+		panic(fmt.Sprintf("Unknown OpType=%d", op))
+	}
+}
@@ -0,0 +1,57 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalPartial(t *testing.T) {
+	t.Run("agrees with Eval on complete assignments", func(t *testing.T) {
+		f := MustParse("((A ^ B) !& (C | !A)) <- (A !| (B -> C)) <-> (A <-> C)")
+		for _, a := range []bool{false, true} {
+			for _, b := range []bool{false, true} {
+				for _, c := range []bool{false, true} {
+					assignment := Assignment{"A": a, "B": b, "C": c}
+					assert.Equal(t, TriOf(f.Eval(assignment)), EvalPartial(f, assignment))
+					assert.Equal(t, TriOf(f.Eval(assignment)), EvalPartialWith(f, assignment, LukasiewiczSemantics))
+				}
+			}
+		}
+	})
+	t.Run("missing variables are unknown", func(t *testing.T) {
+		assert.Equal(t, TriUnknown, EvalPartial(Var("A"), Assignment{}))
+		assert.Equal(t, TriUnknown, EvalPartial(Not(Var("A")), Assignment{}))
+		assert.Equal(t, TriFalse, EvalPartial(And(Var("A"), Var("B")), Assignment{"B": false}))
+		assert.Equal(t, TriUnknown, EvalPartial(And(Var("A"), Var("B")), Assignment{"B": true}))
+		assert.Equal(t, TriTrue, EvalPartial(Or(Var("A"), Var("B")), Assignment{"A": true}))
+		assert.Equal(t, TriTrue, EvalPartial(Implies(Var("A"), Var("B")), Assignment{"A": false}))
+		assert.Equal(t, TriUnknown, EvalPartial(Xor(Var("A"), Var("B")), Assignment{"A": false}))
+		assert.Equal(t, TriTrue, EvalPartial(Nand(Var("A"), Var("B")), Assignment{"A": false}))
+		assert.Equal(t, TriFalse, EvalPartial(Nor(Var("A"), Var("B")), Assignment{"B": true}))
+		assert.Equal(t, TriTrue, EvalPartial(ImpliedBy(Var("A"), Var("B")), Assignment{"A": true}))
+	})
+	t.Run("n-ary operators", func(t *testing.T) {
+		assert.Equal(t, TriFalse, EvalPartial(NewConjunction(Var("A"), Var("B"), Bottom()), Assignment{}))
+		assert.Equal(t, TriUnknown, EvalPartial(NewDisjunction(Var("A"), Var("B")), Assignment{"A": false}))
+		assert.Equal(t, TriUnknown, EvalPartial(NewExclusiveDisjunction(Var("A"), Top(), Var("B")), Assignment{"A": true}))
+		assert.Equal(t, TriTrue, EvalPartial(NewConjunction(), Assignment{}))
+		assert.Equal(t, TriFalse, EvalPartial(NewExclusiveDisjunction(), Assignment{}))
+	})
+	t.Run("Kleene and Lukasiewicz implication", func(t *testing.T) {
+		f := Implies(Var("A"), Var("A"))
+		assert.Equal(t, TriUnknown, EvalPartialWith(f, Assignment{}, KleeneSemantics))
+		assert.Equal(t, TriTrue, EvalPartialWith(f, Assignment{}, LukasiewiczSemantics))
+
+		g := Iff(Var("A"), Var("B"))
+		assert.Equal(t, TriUnknown, EvalPartialWith(g, Assignment{}, KleeneSemantics))
+		assert.Equal(t, TriTrue, EvalPartialWith(g, Assignment{}, LukasiewiczSemantics))
+		assert.Equal(t, TriUnknown, EvalPartialWith(g, Assignment{"A": true}, LukasiewiczSemantics))
+		assert.Equal(t, TriTrue, EvalPartialWith(ImpliedBy(Var("A"), Var("B")), Assignment{}, LukasiewiczSemantics))
+	})
+	t.Run("string representation", func(t *testing.T) {
+		assert.Equal(t, "false", TriFalse.String())
+		assert.Equal(t, "unknown", TriUnknown.String())
+		assert.Equal(t, "true", TriTrue.String())
+	})
+}
@@ -12,6 +12,8 @@ func Var(name string) LogicNode {
 	return &Variable{Name: name}
 }
 
+// Eval returns the value of the variable. It panics if the variable is missing in the
+// assignment; EvalChecked returns an error instead.
 func (v Variable) Eval(assignment Assignment) bool {
 	val, ok := assignment[v.Name]
 	if !ok {
@@ -0,0 +1,140 @@
+package logo
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Vars returns the variable names of the formula f in natural sort order (see NaturalLess).
+// Unlike Scope, the order is deterministic.
+func Vars(f LogicNode) []string {
+	vars := make([]string, 0)
+	for name := range f.Scope() {
+		vars = append(vars, name)
+	}
+	SortNatural(vars)
+	return vars
+}
+
+// VarsByOccurrence returns the variable names of the formula f in the order of their first
+// occurrence when reading the formula from left to right.
+func VarsByOccurrence(f LogicNode) []string {
+	vars := make([]string, 0)
+	seen := make(map[string]struct{})
+	Inspect(f, func(node LogicNode) bool {
+		if v, ok := node.(*Variable); ok {
+			if _, ok := seen[v.Name]; !ok {
+				seen[v.Name] = struct{}{}
+				vars = append(vars, v.Name)
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+// SortNatural sorts the names in natural sort order (see NaturalLess).
+func SortNatural(names []string) {
+	sort.Slice(names, func(i, j int) bool { return NaturalLess(names[i], names[j]) })
+}
+
+// NaturalLess returns true iff a comes before b in natural sort order, which compares
+// runs of digits by their numeric value, e.g., x2 < x10, and all other characters
+// lexicographically. Names that only differ in leading zeros are ordered lexicographically.
+func NaturalLess(a, b string) bool {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		ra, sizeA := utf8.DecodeRuneInString(a[i:])
+		rb, sizeB := utf8.DecodeRuneInString(b[j:])
+		if isDigit(ra) && isDigit(rb) {
+			numA, numB := digitRun(a[i:]), digitRun(b[j:])
+			i, j = i+len(numA), j+len(numB)
+			numA, numB = strings.TrimLeft(numA, "0"), strings.TrimLeft(numB, "0")
+			if len(numA) != len(numB) {
+				return len(numA) < len(numB)
+			}
+			if numA != numB {
+				return numA < numB
+			}
+			continue
+		}
+		if ra != rb {
+			return ra < rb
+		}
+		i, j = i+sizeA, j+sizeB
+	}
+	if len(a)-i != len(b)-j {
+		return len(a)-i < len(b)-j
+	}
+	return a < b
+}
+
+func isDigit(r rune) bool {
+	return r < utf8.RuneSelf && unicode.IsDigit(r)
+}
+
+// digitRun returns the longest prefix of s that consists of ASCII digits.
+func digitRun(s string) string {
+	end := 0
+	for end < len(s) && isDigit(rune(s[end])) {
+		end++
+	}
+	return s[:end]
+}
+
+// VarIndex is an interning table that assigns consecutive indices 0, 1, 2, ... to variable
+// names, such that solvers and printers can share a numbering of the variables.
+type VarIndex struct {
+	names []string
+	index map[string]int
+}
+
+// NewVarIndex returns a VarIndex that contains the given names in this order.
+func NewVarIndex(names ...string) *VarIndex {
+	vi := &VarIndex{index: make(map[string]int, len(names))}
+	for _, name := range names {
+		vi.Intern(name)
+	}
+	return vi
+}
+
+// NewVarIndexOf returns a VarIndex that contains the variables of the formula f in
+// natural sort order.
+func NewVarIndexOf(f LogicNode) *VarIndex {
+	return NewVarIndex(Vars(f)...)
+}
+
+// Intern returns the index of the name and adds it to the table if it is missing.
+func (vi *VarIndex) Intern(name string) int {
+	if i, ok := vi.index[name]; ok {
+		return i
+	}
+	vi.index[name] = len(vi.names)
+	vi.names = append(vi.names, name)
+	return len(vi.names) - 1
+}
+
+// Index returns the index of the name, if it is contained in the table.
+func (vi *VarIndex) Index(name string) (int, bool) {
+	i, ok := vi.index[name]
+	return i, ok
+}
+
+// Name returns the name with index i.
+func (vi *VarIndex) Name(i int) string {
+	return vi.names[i]
+}
+
+// Len returns the number of names in the table.
+func (vi *VarIndex) Len() int {
+	return len(vi.names)
+}
+
+// Names returns all names of the table ordered by their index.
+func (vi *VarIndex) Names() []string {
+	names := make([]string, len(vi.names))
+	copy(names, vi.names)
+	return names
+}
@@ -0,0 +1,56 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVars(t *testing.T) {
+	t.Run("natural sort order", func(t *testing.T) {
+		f := MustParse("x10 & (x2 | B) & !A & x1 & (x2 -> A)")
+		assert.Equal(t, []string{"A", "B", "x1", "x2", "x10"}, Vars(f))
+	})
+	t.Run("constant formula has no variables", func(t *testing.T) {
+		assert.Empty(t, Vars(Top()))
+	})
+	t.Run("first occurrence order", func(t *testing.T) {
+		f := MustParse("x10 & (x2 | B) & !A & x1 & (x2 -> A)")
+		assert.Equal(t, []string{"x10", "x2", "B", "A", "x1"}, VarsByOccurrence(f))
+	})
+}
+
+func TestNaturalLess(t *testing.T) {
+	t.Run("sorts numbers by value", func(t *testing.T) {
+		names := []string{"x10", "y", "x2", "x", "x1a", "x1", "a10b2", "a10b10", "a9", "x02", "B", "A"}
+		SortNatural(names)
+		assert.Equal(t, []string{"A", "B", "a9", "a10b2", "a10b10", "x", "x1", "x1a", "x02", "x2", "x10", "y"}, names)
+	})
+	t.Run("strict order", func(t *testing.T) {
+		assert.False(t, NaturalLess("x1", "x1"))
+		assert.True(t, NaturalLess("x01", "x1"))
+		assert.False(t, NaturalLess("x1", "x01"))
+	})
+}
+
+func TestVarIndex(t *testing.T) {
+	t.Run("interning", func(t *testing.T) {
+		vi := NewVarIndex("B", "A")
+		assert.Equal(t, 2, vi.Len())
+		assert.Equal(t, 1, vi.Intern("A"))
+		assert.Equal(t, 2, vi.Intern("C"))
+		assert.Equal(t, 3, vi.Len())
+
+		i, ok := vi.Index("C")
+		assert.True(t, ok)
+		assert.Equal(t, 2, i)
+		_, ok = vi.Index("D")
+		assert.False(t, ok)
+		assert.Equal(t, "B", vi.Name(0))
+		assert.Equal(t, []string{"B", "A", "C"}, vi.Names())
+	})
+	t.Run("variables of a formula", func(t *testing.T) {
+		vi := NewVarIndexOf(MustParse("x10 | x9"))
+		assert.Equal(t, []string{"x9", "x10"}, vi.Names())
+	})
+}
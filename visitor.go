@@ -0,0 +1,132 @@
+package logo
+
+import "fmt"
+
+// Children returns the direct subformulas of f in order: the operand of a NotOp,
+// the operands X and Y of a BinaryOp, or the clauses of a NaryOp. Variables and
+// leaves have no children. The returned slice may be modified by the caller.
+func Children(f LogicNode) []LogicNode {
+	switch f1 := f.(type) {
+	case *NotOp:
+		return []LogicNode{f1.X}
+	case *BinaryOp:
+		return []LogicNode{f1.X, f1.Y}
+	case *NaryOp:
+		children := make([]LogicNode, len(f1.Clauses))
+		copy(children, f1.Clauses)
+		return children
+	case *Variable, Leaf:
+		return nil
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+// WithChildren returns a copy of the node f whose children are replaced by the given
+// children. The number of children must match the number of children of f. Variables
+// and leaves are returned as they are.
+func WithChildren(f LogicNode, children []LogicNode) LogicNode {
+	switch f1 := f.(type) {
+	case *NotOp:
+		mustHaveChildren(f1, children, 1)
+		return &NotOp{X: children[0]}
+	case *BinaryOp:
+		mustHaveChildren(f1, children, 2)
+		return &BinaryOp{X: children[0], Y: children[1], Op: f1.Op}
+	case *NaryOp:
+		mustHaveChildren(f1, children, len(f1.Clauses))
+		var clauses []LogicNode
+		if f1.Clauses != nil {
+			clauses = make([]LogicNode, len(children))
+			copy(clauses, children)
+		}
+		return &NaryOp{Clauses: clauses, Op: f1.Op}
+	case *Variable, Leaf:
+		mustHaveChildren(f1, children, 0)
+		return f1
+	default:
+		panic(fmt.Sprintf("Unkown type=%T of subformula=%s", f1, f1))
+	}
+}
+
+func mustHaveChildren(f LogicNode, children []LogicNode, num int) {
+	if len(children) != num {
+		panic(fmt.Sprintf("Node of type=%T expects %d children, got %d", f, num, len(children)))
+	}
+}
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node LogicNode) (w Visitor)
+}
+
+// Walk traverses the formula f in depth-first order: it starts by calling
+// v.Visit(f); f must not be nil. If the visitor w returned by v.Visit(f) is
+// not nil, Walk is invoked recursively with visitor w for each of the children
+// of f, followed by a call of w.Visit(nil).
+func Walk(v Visitor, f LogicNode) {
+	if v = v.Visit(f); v == nil {
+		return
+	}
+	for _, child := range Children(f) {
+		Walk(v, child)
+	}
+	v.Visit(nil)
+}
+
+type inspector func(LogicNode) bool
+
+func (fn inspector) Visit(node LogicNode) Visitor {
+	if fn(node) {
+		return fn
+	}
+	return nil
+}
+
+// Inspect traverses the formula f in depth-first order: it starts by calling
+// fn(f); f must not be nil. If fn returns true, Inspect invokes fn recursively
+// for each of the children of f, followed by a call of fn(nil).
+func Inspect(f LogicNode, fn func(LogicNode) bool) {
+	Walk(inspector(fn), f)
+}
+
+// Fold computes a value of the formula f bottom-up: fn is called for each node
+// with the values already computed for the node's children (see Children).
+func Fold[T any](f LogicNode, fn func(node LogicNode, children []T) T) T {
+	children := Children(f)
+	values := make([]T, len(children))
+	for i, child := range children {
+		values[i] = Fold(child, fn)
+	}
+	return fn(f, values)
+}
+
+// Size returns the number of nodes of the formula f.
+func Size(f LogicNode) int {
+	return Fold(f, func(_ LogicNode, children []int) int {
+		size := 1
+		for _, childSize := range children {
+			size += childSize
+		}
+		return size
+	})
+}
+
+// Depth returns the length of the longest path from the root of the formula f to
+// one of its leaves. Variables and constants have depth 0.
+func Depth(f LogicNode) int {
+	return Fold(f, func(_ LogicNode, children []int) int {
+		if len(children) == 0 {
+			return 0
+		}
+		depth := 0
+		for _, childDepth := range children {
+			if childDepth > depth {
+				depth = childDepth
+			}
+		}
+		return depth + 1
+	})
+}
@@ -0,0 +1,113 @@
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChildren(t *testing.T) {
+	t.Run("children of each node type", func(t *testing.T) {
+		a, b, c := Var("A"), Var("B"), Var("C")
+		assert.Equal(t, []LogicNode{a}, Children(Not(a)))
+		assert.Equal(t, []LogicNode{a, b}, Children(Implies(a, b)))
+		assert.Equal(t, []LogicNode{a, b, c}, Children(NewConjunction(a, b, c)))
+		assert.Empty(t, Children(a))
+		assert.Empty(t, Children(Top()))
+	})
+	t.Run("modifying the children leaves the node unchanged", func(t *testing.T) {
+		f := NewDisjunction(Var("A"), Var("B"))
+		Children(f)[0] = Bottom()
+		assert.Equal(t, "(A | B)", f.String())
+	})
+}
+
+func TestWithChildren(t *testing.T) {
+	t.Run("replaces the children of a copy", func(t *testing.T) {
+		f := Iff(Var("A"), Var("B"))
+		g := WithChildren(f, []LogicNode{Var("C"), Not(Var("D"))})
+		assert.Equal(t, "(C <-> !D)", g.String())
+		assert.Equal(t, "(A <-> B)", f.String())
+
+		n := NewConjunction(Var("A"), Var("B"))
+		assert.Equal(t, "(B & A)", WithChildren(n, []LogicNode{Var("B"), Var("A")}).String())
+		assert.Equal(t, "!true", WithChildren(Not(Var("A")), []LogicNode{Top()}).String())
+	})
+	t.Run("variables and leaves are returned as they are", func(t *testing.T) {
+		a := Var("A")
+		assert.Same(t, a, WithChildren(a, nil))
+		assert.Equal(t, Top(), WithChildren(Top(), nil))
+	})
+	t.Run("wrong number of children", func(t *testing.T) {
+		assert.Panics(t, func() { WithChildren(And(Var("A"), Var("B")), []LogicNode{Var("A")}) })
+		assert.Panics(t, func() { WithChildren(Var("A"), []LogicNode{Var("B")}) })
+	})
+}
+
+type countingVisitor struct {
+	visited []string
+	exits   *int
+}
+
+func (v *countingVisitor) Visit(node LogicNode) Visitor {
+	if node == nil {
+		*v.exits++
+		return nil
+	}
+	v.visited = append(v.visited, node.String())
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("visits all nodes in depth-first order", func(t *testing.T) {
+		exits := 0
+		v := &countingVisitor{exits: &exits}
+		Walk(v, And(Not(Var("A")), NewDisjunction(Var("B"), Top())))
+		assert.Equal(t, []string{"(!A & (B | true))", "!A", "A", "(B | true)", "B", "true"}, v.visited)
+		assert.Equal(t, 6, exits)
+	})
+}
+
+func TestInspect(t *testing.T) {
+	t.Run("stops descending if fn returns false", func(t *testing.T) {
+		visited := make([]string, 0)
+		Inspect(Or(Not(Var("A")), And(Var("B"), Var("C"))), func(node LogicNode) bool {
+			if node == nil {
+				return false
+			}
+			visited = append(visited, node.String())
+			_, isNot := node.(*NotOp)
+			return !isNot
+		})
+		assert.Equal(t, []string{"(!A | (B & C))", "!A", "(B & C)", "B", "C"}, visited)
+	})
+}
+
+func TestFold(t *testing.T) {
+	t.Run("counts variable occurrences", func(t *testing.T) {
+		f := Implies(And(Var("A"), Var("B")), NewDisjunction(Var("A"), Not(Var("C")), Bottom()))
+		count := Fold(f, func(node LogicNode, children []int) int {
+			if _, ok := node.(*Variable); ok {
+				return 1
+			}
+			sum := 0
+			for _, c := range children {
+				sum += c
+			}
+			return sum
+		})
+		assert.Equal(t, 4, count)
+	})
+}
+
+func TestSizeAndDepth(t *testing.T) {
+	t.Run("single node", func(t *testing.T) {
+		assert.Equal(t, 1, Size(Var("A")))
+		assert.Equal(t, 0, Depth(Var("A")))
+	})
+	t.Run("nested formula", func(t *testing.T) {
+		f := And(Not(Not(Var("A"))), NewDisjunction(Var("B"), Var("C"), Top()))
+		assert.Equal(t, 8, Size(f))
+		assert.Equal(t, 3, Depth(f))
+	})
+}